@@ -0,0 +1,232 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/crawler"
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/database"
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/parser"
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/scheduler"
+)
+
+// daemonOptions jsou rozvrhy úloh pro -daemon; nulový interval nebo prázdné
+// okno úlohu vypne
+type daemonOptions struct {
+	incrementalEvery time.Duration
+	refreshEvery     time.Duration
+	refreshPages     int
+	backfillWindow   string
+	backfillPages    int
+}
+
+// daemon spouští crawlery podle rozvrhu, vždy jen jeden najednou
+type daemon struct {
+	config crawler.Config
+	db     *database.Database
+
+	mu      sync.Mutex
+	current *crawler.Crawler // právě běžící crawler (pro šetrné ukončení)
+	stopped bool
+}
+
+// runDaemon poběží, dokud nepřijde SIGINT/SIGTERM. První signál dokončí
+// rozpracované stránky a další úlohy už nespustí, druhý ukončí okamžitě.
+func runDaemon(config crawler.Config, db *database.Database, opts daemonOptions) error {
+	d := &daemon{config: config, db: db}
+
+	var jobs []scheduler.Job
+	if opts.incrementalEvery > 0 {
+		jobs = append(jobs, scheduler.Job{
+			Name:     database.KindIncremental,
+			Schedule: scheduler.Every(opts.incrementalEvery),
+			Run:      d.incremental,
+		})
+	}
+	if opts.refreshEvery > 0 {
+		jobs = append(jobs, scheduler.Job{
+			Name:     database.KindRefresh,
+			Schedule: scheduler.Every(opts.refreshEvery),
+			Run: func(ctx context.Context) error {
+				return d.refresh(ctx, opts.refreshPages)
+			},
+		})
+	}
+	if opts.backfillWindow != "" {
+		// Noc podle sktorrentu, ne podle časového pásma kontejneru
+		window, err := scheduler.ParseWindow(opts.backfillWindow, parser.Location)
+		if err != nil {
+			return err
+		}
+		jobs = append(jobs, scheduler.Job{
+			Name:     database.KindBackfill,
+			Schedule: window,
+			Run: func(ctx context.Context) error {
+				return d.backfill(ctx, window, opts.backfillPages)
+			},
+		})
+	}
+	if len(jobs) == 0 {
+		return errors.New("daemon has no schedules enabled")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sched := scheduler.New(os.Stdout, jobs...)
+
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	go func() {
+		sig := <-signals
+		fmt.Printf("\n⏹️  Přijat signál %v - dokončuji rozpracovanou úlohu (dalším signálem ukončíš okamžitě)\n", sig)
+		sched.Stop()
+		d.stop()
+		<-signals
+		fmt.Printf("⏹️  Okamžité ukončení\n")
+		cancel()
+	}()
+
+	err := sched.Run(ctx)
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
+
+// incremental projde nejnovější stránky, dokud nenarazí na známé torrenty
+func (d *daemon) incremental(ctx context.Context) error {
+	config := d.config
+	config.RunKind = database.KindIncremental
+	config.Incremental = true
+	return d.crawl(ctx, config, func(c *crawler.Crawler) crawler.Summary {
+		return c.Crawl(ctx, 0, maxIncrementalPages-1)
+	})
+}
+
+// refresh projde prvních pages stránek celé, aby se obnovily seeds/leeches
+// i u torrentů, které inkrementální běh přeskočí
+func (d *daemon) refresh(ctx context.Context, pages int) error {
+	config := d.config
+	config.RunKind = database.KindRefresh
+	return d.crawl(ctx, config, func(c *crawler.Crawler) crawler.Summary {
+		return c.Crawl(ctx, 0, pages-1)
+	})
+}
+
+// backfill prochází během okna pages stránek výpisu. Každý běh navazuje za
+// stránkou, kde skončil minulý, a na konci výpisu začne znovu od začátku.
+// Nedokončený backfill z minulé noci pokračuje tam, kde skončil.
+func (d *daemon) backfill(ctx context.Context, window scheduler.Window, pages int) error {
+	end := window.EndAfter(time.Now())
+	if end.IsZero() {
+		// Předchozí úloha běžela přes celé okno
+		fmt.Println("⏭️  Backfill: okno už skončilo, přeskakuji")
+		return nil
+	}
+
+	config := d.config
+	config.RunKind = database.KindBackfill
+
+	last, err := d.db.LatestCrawlRun(ctx, database.KindBackfill)
+	if err != nil {
+		return err
+	}
+	resume := last != nil && last.Status != database.RunDone && last.Status != database.RunCaughtUp &&
+		last.Status != database.RunLayout
+
+	var from, to int
+	if resume {
+		// Když navázat nepůjde, projde se stejné okno znovu
+		from, to = last.FromPage, last.ToPage
+	} else {
+		listing, err := crawler.NewCrawler(config).FindLastPage(ctx)
+		if err != nil {
+			return fmt.Errorf("finding last page: %w", err)
+		}
+		if listing.Page < 0 {
+			fmt.Println("⏭️  Backfill: výpis je prázdný, přeskakuji")
+			return nil
+		}
+		from, to = backfillPages(last, pages, listing.Page)
+		fmt.Printf("📚 Backfill: stránky %d-%d z %d\n", from, to, listing.Pages())
+	}
+
+	return d.crawl(ctx, config, func(c *crawler.Crawler) crawler.Summary {
+		// Na konci okna se rozpracované stránky dokončí a běh zůstane
+		// přerušený, další noc na něj naváže
+		timer := time.AfterFunc(time.Until(end), c.Stop)
+		defer timer.Stop()
+
+		if resume {
+			fmt.Printf("🔁 Backfill navazuje na běh #%d\n", last.ID)
+			summary, err := c.Resume(ctx, last.ID)
+			if err == nil {
+				return summary
+			}
+			fmt.Printf("⚠️  Nelze navázat na běh #%d: %v\n", last.ID, err)
+		}
+		return c.Crawl(ctx, from, to)
+	})
+}
+
+// backfillPages vrátí stránky dalšího backfillu: pages stránek za ToPage
+// minulého běhu, za poslední stránkou výpisu (lastPage) znovu od 0. Běh
+// ukončený změnou layoutu se po opravě parseru zopakuje celý.
+func backfillPages(last *database.CrawlRun, pages, lastPage int) (from, to int) {
+	if last != nil {
+		from = last.ToPage + 1
+		if last.Status == database.RunLayout {
+			from = last.FromPage
+		}
+	}
+	if from > lastPage {
+		from = 0
+	}
+	return from, min(from+pages-1, lastPage)
+}
+
+// crawl spustí jeden běh crawleru a převede jeho výsledek na chybu úlohy
+func (d *daemon) crawl(ctx context.Context, config crawler.Config, run func(*crawler.Crawler) crawler.Summary) error {
+	c := crawler.NewCrawler(config)
+
+	d.mu.Lock()
+	if d.stopped {
+		d.mu.Unlock()
+		return nil
+	}
+	d.current = c
+	d.mu.Unlock()
+	defer func() {
+		d.mu.Lock()
+		d.current = nil
+		d.mu.Unlock()
+	}()
+
+	summary := run(c)
+	switch {
+	case summary.LayoutError != nil:
+		return summary.LayoutError
+	case summary.AbortError != nil:
+		return summary.AbortError
+	case summary.StopReason == crawler.StopErrors:
+		return fmt.Errorf("stopped after %d consecutive errors", summary.ConsecutiveErrors)
+	}
+	return ctx.Err()
+}
+
+// stop zastaví rozpracovaný crawler a nedovolí spustit další
+func (d *daemon) stop() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.stopped = true
+	if d.current != nil {
+		d.current.Stop()
+	}
+}
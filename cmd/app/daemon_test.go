@@ -0,0 +1,39 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/database"
+)
+
+func TestBackfillPages(t *testing.T) {
+	run := func(status string, from, to int) *database.CrawlRun {
+		return &database.CrawlRun{Status: status, FromPage: from, ToPage: to}
+	}
+
+	tests := []struct {
+		name     string
+		last     *database.CrawlRun
+		pages    int
+		lastPage int
+		wantFrom int
+		wantTo   int
+	}{
+		{"první backfill", nil, 100, 5000, 0, 99},
+		{"navazuje za minulým během", run(database.RunDone, 0, 99), 100, 5000, 100, 199},
+		{"okno se zkrátí na konec výpisu", run(database.RunDone, 4800, 4899), 200, 4950, 4900, 4950},
+		{"za koncem výpisu od začátku", run(database.RunDone, 4900, 4950), 100, 4950, 0, 99},
+		{"výpis se mezitím zkrátil", run(database.RunDone, 6000, 6099), 100, 4950, 0, 99},
+		{"dohnaný běh také navazuje", run(database.RunCaughtUp, 100, 199), 100, 5000, 200, 299},
+		{"změna layoutu zopakuje okno", run(database.RunLayout, 300, 399), 100, 5000, 300, 399},
+		{"výpis kratší než okno", nil, 1000, 42, 0, 42},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := backfillPages(tt.last, tt.pages, tt.lastPage)
+			if from != tt.wantFrom || to != tt.wantTo {
+				t.Errorf("backfillPages = %d-%d, want %d-%d", from, to, tt.wantFrom, tt.wantTo)
+			}
+		})
+	}
+}
@@ -1,40 +1,236 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
+	"os"
+	"os/signal"
+	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/archive"
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/config"
 	"github.com/JaLe29/search-me-plz-sktorrent/internal/crawler"
 	"github.com/JaLe29/search-me-plz-sktorrent/internal/database"
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/parser"
+)
+
+// maxIncrementalPages je pojistka pro -incremental bez -to
+const maxIncrementalPages = 500
+
+// Návratové kódy, podle kterých cron nebo CI pozná, proč crawling skončil
+const (
+	exitErrors        = 2 // crawling ukončily chyby podle ErrorPolicy (-max-errors, -abort-on)
+	exitLayoutChanged = 3 // sktorrent změnil layout stránek
 )
 
 func main() {
+	os.Exit(run())
+}
+
+// run je celé tělo main; vrací návratový kód, aby se před os.Exit stihly
+// provést defery (zavření databáze, archivu a souboru událostí)
+func run() int {
+	// Nastavení ze souboru a prostředí jsou výchozí hodnoty parametrů
+	configFile := config.PathFromArgs(os.Args[1:])
+	cfg, err := config.Load(configFile)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
 	// Definice příkazových parametrů
 	var (
-		fromPage = flag.Int("from", 0, "Počáteční stránka pro crawling (začíná od 0)")
-		toPage   = flag.Int("to", 2, "Koncová stránka pro crawling")
-		workers  = flag.Int("workers", 3, "Počet paralelních workerů")
-		timeout  = flag.Int("timeout", 30, "Timeout pro HTTP požadavky (sekundy)")
-		dbPath   = flag.String("db", "torrents.db", "Cesta k SQLite databázi")
+		_           = flag.String("config", configFile, "YAML soubor s nastavením (viz config.example.yaml)")
+		fromPage    = flag.Int("from", 0, "Počáteční stránka pro crawling (začíná od 0)")
+		toPage      = flag.Int("to", 2, "Koncová stránka pro crawling")
+		allPages    = flag.Bool("all", false, "Najít poslední stránku výpisu a projít vše od -from až po ni")
+		workers     = flag.Int("workers", cfg.Crawler.Workers, "Max. počet paralelních workerů (souběžnost se přizpůsobuje odezvě)")
+		fixedWork   = flag.Bool("fixed-workers", cfg.Crawler.FixedWorkers, "Vždy -workers workerů, bez přizpůsobování odezvě serveru")
+		latency     = flag.Duration("target-latency", cfg.Crawler.TargetLatency, "Dokud odpovědi chodí rychleji, přidávají se workery")
+		timeout     = flag.Int("timeout", int(cfg.Crawler.Timeout/time.Second), "Timeout pro HTTP požadavky (sekundy)")
+		dbPath      = flag.String("db", cfg.Database.Path, "Cesta k SQLite databázi")
+		baseURL     = flag.String("base-url", cfg.Crawler.BaseURL, "Adresa výpisu torrentů (torrents_v2.php)")
+		record      = flag.String("record", "", "Adresář, do kterého se uloží všechny HTTP odpovědi")
+		replay      = flag.String("replay", "", "Adresář s nahranými odpověďmi (crawling bez sítě)")
+		archivePath = flag.String("archive", cfg.Crawler.Archive, "Archiv stažených stránek (SQLite) pro pozdější přepočet přes cmd/reparse")
+		rate        = flag.Float64("rate", cfg.Crawler.Rate, "Max. požadavků za sekundu na sktorrent (0 = bez limitu)")
+		burst       = flag.Int("burst", cfg.Crawler.Burst, "Kolik požadavků smí odejít najednou")
+		robots      = flag.Bool("robots", cfg.Crawler.Robots, "Stáhnout robots.txt a řídit se jím (včetně Crawl-delay)")
+		retries     = flag.Int("retries", cfg.Crawler.Retries, "Max. počet pokusů o stažení stránky")
+		retryMin    = flag.Duration("retry-delay", time.Second, "Prodleva před prvním opakováním (dále exponenciálně)")
+		retryMax    = flag.Duration("retry-max-delay", 30*time.Second, "Maximální prodleva mezi pokusy")
+		retryFailed = flag.Bool("retry-failed", false, "Zopakovat jen stránky, které dříve selhaly")
+		abortOn     = flag.String("abort-on", strings.Join(cfg.Crawler.AbortOn, ","), "Třídy chyb, které okamžitě ukončí crawling (např. http4xx,parse)")
+		skipOn      = flag.String("skip-on", strings.Join(cfg.Crawler.SkipOn, ","), "Třídy chyb, které se jen přeskočí (např. http5xx,ratelimit)")
+		maxErrors   = flag.Int("max-errors", cfg.Crawler.MaxErrors, "Po kolika po sobě jdoucích HTTP chybách crawling skončí")
+		maxMissing  = flag.Float64("max-missing", crawler.DefaultQualityPolicy.MaxMissing, "Max. podíl vadných řádků na stránce, než se ohlásí změna layoutu")
+		incremental = flag.Bool("incremental", cfg.Crawler.Incremental, "Procházet stránky, dokud nenarazí na už známé torrenty")
+		knownLimit  = flag.Int("known", 0, "Inkrementální režim skončí po N po sobě jdoucích známých torrentech (0 = celá stránka)")
+		since       = flag.String("since", "", "Inkrementální režim: ignorovat torrenty přidané před datem (YYYY-MM-DD)")
+		resume      = flag.Int64("resume", 0, "Navázat na nedokončený běh s daným ID")
+		details     = flag.Bool("details", cfg.Crawler.Details, "Stáhnout detail stránku pro každý torrent (ne jen pro ty s ČSFD)")
+		refreshDet  = flag.Bool("refresh-details", false, "Stáhnout detail stránky i pro torrenty, které už v databázi máme")
+		force       = flag.Bool("force", false, "Zpracovat i stránky, které se od minula nezměnily (např. po změně parseru)")
+		category    = flag.String("category", "", "Procházet jen jednu kategorii (číslo nebo název, např. \"Seriál\")")
+		genre       = flag.String("genre", "", "Procházet jen jeden žánr (parametr zaner)")
+		lang        = flag.String("lang", "", "Procházet jen jeden jazyk (parametr jazyk)")
+		order       = flag.String("order", "date", "Řazení výpisu: date, name, size, seeds, leeches")
+		ascending   = flag.Bool("asc", false, "Řadit výpis vzestupně")
+		activeOnly  = flag.Bool("active", false, "Jen torrenty, které někdo seeduje")
+		progress    = flag.Bool("progress", cfg.Log.Progress, "Místo výpisu každého torrentu zobrazit pruh postupu")
+		eventsFile  = flag.String("events", cfg.Log.Events, "Soubor, do kterého se připisují události crawleru jako JSON lines")
+
+		daemonMode    = flag.Bool("daemon", false, "Běžet trvale a spouštět crawling podle rozvrhu (místo cronu)")
+		everyIncr     = flag.Duration("incremental-every", 15*time.Minute, "Daemon: interval inkrementálního crawlu (0 = vypnuto)")
+		everyRefresh  = flag.Duration("refresh-every", 6*time.Hour, "Daemon: interval obnovy stats hlubších stránek (0 = vypnuto)")
+		refreshPages  = flag.Int("refresh-pages", 50, "Daemon: kolik stránek obnova stats projde")
+		backfillSpec  = flag.String("backfill-window", "02:00-05:00", "Daemon: noční okno pro backfill v čase sktorrentu (\"\" = vypnuto)")
+		backfillPages = flag.Int("backfill-pages", 1000, "Daemon: kolik stránek backfill projde za noc (další noc pokračuje dál, za koncem výpisu od 0)")
 	)
 	flag.Parse()
 
+	// Parametry mají přednost před souborem i prostředím
+	cfg.Database.Path = *dbPath
+	cfg.Crawler.Workers = *workers
+	cfg.Crawler.FixedWorkers = *fixedWork
+	cfg.Crawler.TargetLatency = *latency
+	if isFlagSet("timeout") {
+		cfg.Crawler.Timeout = time.Duration(*timeout) * time.Second
+	}
+	cfg.Crawler.Rate = *rate
+	cfg.Crawler.Burst = *burst
+	cfg.Crawler.Robots = *robots
+	cfg.Crawler.Retries = *retries
+	cfg.Crawler.MaxErrors = *maxErrors
+	cfg.Crawler.Details = *details
+	cfg.Crawler.Incremental = *incremental
+	cfg.Crawler.BaseURL = *baseURL
+	cfg.Crawler.Archive = *archivePath
+	cfg.Log.Progress = *progress
+	cfg.Log.Events = *eventsFile
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("❌ Neplatné nastavení:\n%v", err)
+	}
+	// Inkrementální režim z nastavení neplatí pro běhy, které si rozsah
+	// stránek určují samy; zadaný parametr -incremental se s nimi hlásí jako konflikt
+	if (*daemonMode || *allPages || *resume != 0 || *retryFailed) && !isFlagSet("incremental") {
+		cfg.Crawler.Incremental = false
+	}
+	*incremental = cfg.Crawler.Incremental
+
+	if *daemonMode && (*incremental || *resume != 0 || *retryFailed) {
+		log.Fatal("❌ Parametr -daemon nelze kombinovat s -incremental, -resume ani -retry-failed")
+	}
+	if *allPages && (*daemonMode || *incremental || *resume != 0 || *retryFailed || isFlagSet("to")) {
+		log.Fatal("❌ Parametr -all nelze kombinovat s -to, -daemon, -incremental, -resume ani -retry-failed")
+	}
+	if *allPages {
+		// Konec výpisu zjistí crawler, -to jen projde validací
+		*toPage = *fromPage
+	}
+	if *daemonMode && (*refreshPages < 1 || *backfillPages < 1) {
+		log.Fatal("❌ -refresh-pages a -backfill-pages musí být alespoň 1")
+	}
+
+	// V inkrementálním režimu je -to jen pojistka; když není zadané, nehádáme
+	if *incremental && !isFlagSet("to") {
+		*toPage = *fromPage + maxIncrementalPages - 1
+	}
+	var sinceDate time.Time
+	if *since != "" {
+		if !*incremental {
+			log.Fatal("❌ Parametr -since má smysl jen s -incremental")
+		}
+		parsed, err := time.ParseInLocation("2006-01-02", *since, parser.Location)
+		if err != nil {
+			log.Fatalf("❌ Neplatné datum -since %q, použij formát 2025-07-01", *since)
+		}
+		sinceDate = parsed
+	}
+
 	// Validace parametrů
 	if *fromPage < 0 || *toPage < 0 || *fromPage > *toPage {
 		log.Fatal("❌ Neplatné rozmezí stránek. Použij -from=0 -to=10")
 	}
-	if *workers < 1 || *workers > 20 {
-		log.Fatal("❌ Počet workerů musí být mezi 1-20")
+	if *record != "" && *replay != "" {
+		log.Fatal("❌ Parametry -record a -replay nelze kombinovat")
+	}
+	errorPolicy, err := buildErrorPolicy(*abortOn, *skipOn, *maxErrors)
+	if err != nil {
+		log.Fatalf("❌ %v (třídy: http4xx, http5xx, ratelimit, network, parse, layout, other)", err)
+	}
+	if *maxMissing <= 0 || *maxMissing > 1 {
+		log.Fatal("❌ -max-missing musí být mezi 0 a 1")
+	}
+	if *replay != "" {
+		// Přehrávání z disku nemá důvod brzdit
+		*rate = 0
+	}
+	listingOrder, err := crawler.ParseListingOrder(*order)
+	if err != nil {
+		log.Fatalf("❌ %v (řazení: date, name, size, seeds, leeches)", err)
+	}
+	if *incremental && (listingOrder != crawler.OrderDate || *ascending) {
+		log.Fatal("❌ Inkrementální režim potřebuje výpis od nejnovějších (-order date bez -asc)")
+	}
+	listing := crawler.ListingQuery{
+		BaseURL:    cfg.Crawler.BaseURL,
+		Genre:      *genre,
+		Language:   *lang,
+		Order:      listingOrder,
+		Ascending:  *ascending,
+		ActiveOnly: *activeOnly,
+	}
+	categoryName := ""
+	if *category != "" {
+		if id, err := strconv.Atoi(*category); err == nil {
+			listing.Category = id
+		} else {
+			categoryName = *category
+		}
 	}
 
 	fmt.Printf("🚀 Spouštím SkTorrent Crawler\n")
-	fmt.Printf("📄 Stránky: %d - %d\n", *fromPage, *toPage)
-	fmt.Printf("⚙️  Workery: %d\n", *workers)
-	fmt.Printf("⏱️  Timeout: %ds\n", *timeout)
+	if *daemonMode {
+		fmt.Printf("🕰️  Daemon: úlohy podle rozvrhu\n")
+	} else if *incremental {
+		fmt.Printf("📄 Inkrementálně od stránky %d (nejvýše do %d)\n", *fromPage, *toPage)
+		if !sinceDate.IsZero() {
+			fmt.Printf("📅 Jen torrenty přidané od %s\n", sinceDate.Format("02.01.2006"))
+		}
+	} else if *allPages {
+		fmt.Printf("📄 Stránky: od %d do konce výpisu\n", *fromPage)
+	} else {
+		fmt.Printf("📄 Stránky: %d - %d\n", *fromPage, *toPage)
+	}
+	if categoryName != "" {
+		fmt.Printf("🏷️  Výpis: kategorie %q, %s\n", categoryName, listing)
+	} else {
+		fmt.Printf("🏷️  Výpis: %s\n", listing)
+	}
+	if cfg.Crawler.FixedWorkers {
+		fmt.Printf("⚙️  Workery: %d\n", *workers)
+	} else {
+		fmt.Printf("⚙️  Workery: od 1 do %d podle odezvy (cíl %v)\n", *workers, cfg.Crawler.TargetLatency)
+	}
+	fmt.Printf("⏱️  Timeout: %v\n", cfg.Crawler.Timeout)
+	if *rate > 0 {
+		fmt.Printf("🐢 Rate limit: %.1f req/s (burst %d)\n", *rate, *burst)
+	}
 	fmt.Printf("🗃️  Databáze: %s\n", *dbPath)
+	if configFile != "" {
+		fmt.Printf("📄 Nastavení: %s\n", configFile)
+	}
+	if *record != "" {
+		fmt.Printf("📼 Nahrávání odpovědí do: %s\n", *record)
+	}
+	if *replay != "" {
+		fmt.Printf("📼 Přehrávání odpovědí z: %s\n", *replay)
+	}
 	fmt.Println(strings.Repeat("=", 50))
 
 	// Inicializace databáze
@@ -50,20 +246,240 @@ func main() {
 
 	fmt.Printf("✅ Databáze inicializována\n")
 
+	// Kam hlásit průběh - konzole nebo pruh postupu, volitelně i JSON lines do souboru
+	var observer crawler.Observer = crawler.NewConsoleObserver(os.Stdout)
+	if *progress {
+		observer = crawler.NewProgressObserver(os.Stderr)
+	}
+	if *eventsFile != "" {
+		f, err := os.OpenFile(*eventsFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			log.Printf("❌ Nelze otevřít soubor událostí: %v", err)
+			return 1
+		}
+		defer f.Close()
+		observer = crawler.MultiObserver(observer, crawler.NewJSONObserver(f))
+		fmt.Printf("📝 Události se zapisují do: %s\n", *eventsFile)
+	}
+
+	// Zdroj HTTP odpovědí - živě, s nahráváním nebo přehráváním z disku
+	var fetcher crawler.Fetcher = crawler.NewHTTPFetcher(cfg.Crawler.Timeout)
+	if *record != "" {
+		fetcher, err = crawler.NewRecordFetcher(fetcher, *record)
+	} else if *replay != "" {
+		fetcher, err = crawler.NewReplayFetcher(*replay)
+	}
+	if err != nil {
+		log.Printf("❌ Chyba při přípravě fetcheru: %v", err)
+		return 1
+	}
+	if cfg.Crawler.Archive != "" {
+		pages, err := archive.Open(cfg.Crawler.Archive)
+		if err != nil {
+			log.Printf("❌ Chyba při otevírání archivu: %v", err)
+			return 1
+		}
+		defer pages.Close()
+		archiveFetcher := crawler.NewArchiveFetcher(fetcher, pages)
+		archiveFetcher.OnStoreError = func(url string, err error) {
+			fmt.Printf("⚠️  Stránku %s se nepodařilo archivovat: %v\n", url, err)
+		}
+		fetcher = archiveFetcher
+		fmt.Printf("🗄️  Stažené stránky se archivují do: %s\n", cfg.Crawler.Archive)
+	}
+
 	// Konfigurace crawleru
 	config := crawler.Config{
 		Workers:  *workers,
-		Timeout:  time.Duration(*timeout) * time.Second,
+		Listing:  listing,
+		Timeout:  cfg.Crawler.Timeout,
 		Database: db,
+		Fetcher:  fetcher,
+		Observer: observer,
+
+		RateLimit:     *rate,
+		RateBurst:     *burst,
+		RespectRobots: *robots,
+
+		Retry: crawler.RetryPolicy{
+			MaxAttempts: *retries,
+			BaseDelay:   *retryMin,
+			MaxDelay:    *retryMax,
+		},
+		Errors: errorPolicy,
+		Quality: crawler.QualityPolicy{
+			MaxMissing: *maxMissing,
+		},
+		Concurrency: crawler.ConcurrencyPolicy{
+			Fixed:         cfg.Crawler.FixedWorkers,
+			TargetLatency: cfg.Crawler.TargetLatency,
+		},
+
+		FetchDetails:   *details,
+		RefreshDetails: *refreshDet,
+		// Detaily torrentů z nezměněné stránky by se jinak neobnovily
+		IgnorePageCache: *force || *refreshDet,
+
+		Incremental:    *incremental,
+		KnownThreshold: *knownLimit,
+		Since:          sinceDate,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Kategorii zadanou názvem dohledáme v odkazech na výpisu
+	if categoryName != "" {
+		id, err := crawler.NewCrawler(config).ResolveCategory(ctx, categoryName)
+		if err != nil {
+			log.Printf("❌ %v", err)
+			return 1
+		}
+		config.Listing.Category = id
+		fmt.Printf("🏷️  Kategorie %q má číslo %d\n", categoryName, id)
+	}
+
+	if *daemonMode {
+		err := runDaemon(config, db, daemonOptions{
+			incrementalEvery: *everyIncr,
+			refreshEvery:     *everyRefresh,
+			refreshPages:     *refreshPages,
+			backfillWindow:   *backfillSpec,
+			backfillPages:    *backfillPages,
+		})
+		if err != nil {
+			log.Printf("❌ Daemon: %v", err)
+			return 1
+		}
+		fmt.Println("👋 Daemon ukončen")
+		return 0
 	}
 
 	// Vytvoření a spuštění crawleru
 	c := crawler.NewCrawler(config)
 
+	// První Ctrl+C/SIGTERM dokončí rozpracované stránky, druhý ukončí okamžitě
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	go func() {
+		sig := <-signals
+		fmt.Printf("\n⏹️  Přijat signál %v - dokončuji rozpracované stránky (dalším Ctrl+C ukončíš okamžitě)\n", sig)
+		c.Stop()
+		<-signals
+		fmt.Printf("⏹️  Okamžité ukončení\n")
+		cancel()
+	}()
+
 	startTime := time.Now()
-	c.Crawl(*fromPage, *toPage)
+	var summary crawler.Summary
+	if *resume != 0 {
+		summary, err = c.Resume(ctx, *resume)
+		if err != nil {
+			log.Printf("❌ Nelze navázat na běh #%d: %v", *resume, err)
+			return 1
+		}
+	} else if *retryFailed {
+		failed, err := db.GetFailedPages(ctx)
+		if err != nil {
+			log.Printf("❌ Chyba při načítání neúspěšných stránek: %v", err)
+			return 1
+		}
+		if len(failed) == 0 {
+			fmt.Println("✅ Žádné neúspěšné stránky k opakování")
+			return 0
+		}
+		pages := make([]int, 0, len(failed))
+		for _, p := range failed {
+			pages = append(pages, p.Page)
+		}
+		fmt.Printf("🔁 Opakuji %d neúspěšných stránek\n", len(pages))
+		summary = c.CrawlPages(ctx, pages)
+	} else if *allPages {
+		fmt.Println("🔢 Hledám poslední stránku výpisu...")
+		summary, err = c.CrawlAll(ctx, *fromPage)
+		if err != nil {
+			log.Printf("❌ %v", err)
+			return 1
+		}
+	} else {
+		summary = c.Crawl(ctx, *fromPage, *toPage)
+	}
 	duration := time.Since(startTime)
 
+	printDatabaseStats(db)
 	fmt.Printf("\n⏱️  Celkový čas: %v\n", duration)
+	if summary.LayoutErrors > 0 {
+		fmt.Println("🧩 Změna layoutu sktorrentu - je potřeba upravit parser")
+		return exitLayoutChanged
+	}
+	if summary.StopReason == crawler.StopErrors {
+		fmt.Println("🛑 Crawling ukončily chyby, uložena jen část stránek")
+		return exitErrors
+	}
+	if summary.StopReason == crawler.StopInterrupted {
+		fmt.Println("⏹️  Přerušeno, uložena jen část stránek")
+		return 0
+	}
 	fmt.Println("🎉 Hotovo!")
+	return 0
+}
+
+// printDatabaseStats vypíše počty torrentů v databázi po kategoriích
+func printDatabaseStats(db *database.Database) {
+	stats, err := db.GetStats()
+	if err != nil {
+		return
+	}
+	fmt.Printf("\n📈 STATISTIKY DATABÁZE:\n")
+	fmt.Printf("  🗃️  Celkem torrentů: %d\n", stats["total"])
+	for category, count := range stats {
+		if category != "total" && count > 0 {
+			fmt.Printf("  📁 %s: %d\n", category, count)
+		}
+	}
+}
+
+// buildErrorPolicy upraví výchozí ErrorPolicy podle -abort-on a -skip-on
+func buildErrorPolicy(abortOn, skipOn string, maxErrors int) (crawler.ErrorPolicy, error) {
+	policy := crawler.DefaultErrorPolicy()
+	if maxErrors < 1 {
+		return policy, fmt.Errorf("-max-errors musí být alespoň 1")
+	}
+	policy.MaxConsecutive = maxErrors
+
+	apply := func(list string, action crawler.ErrorAction) error {
+		for _, name := range strings.Split(list, ",") {
+			if strings.TrimSpace(name) == "" {
+				continue
+			}
+			class, err := crawler.ParseErrorClass(name)
+			if err != nil {
+				return err
+			}
+			rule := policy.Rules[class]
+			rule.Action = action
+			policy.Rules[class] = rule
+		}
+		return nil
+	}
+
+	if err := apply(skipOn, crawler.ActionSkip); err != nil {
+		return policy, err
+	}
+	if err := apply(abortOn, crawler.ActionAbort); err != nil {
+		return policy, err
+	}
+	return policy, nil
+}
+
+// isFlagSet zjistí, zda byl parametr zadán na příkazové řádce
+func isFlagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
 }
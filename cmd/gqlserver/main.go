@@ -1,26 +1,54 @@
 package main
 
 import (
+	"flag"
 	"log"
 	"net/http"
 	"os"
+	"strings"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/JaLe29/search-me-plz-sktorrent/graphql"
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/config"
 	"github.com/JaLe29/search-me-plz-sktorrent/internal/database"
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/magnet"
 )
 
-const defaultPort = "8080"
-
 func main() {
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = defaultPort
+	// Nastavení ze souboru a prostředí (včetně PORT a TRACKERS) jsou
+	// výchozí hodnoty parametrů
+	configFile := config.PathFromArgs(os.Args[1:])
+	cfg, err := config.Load(configFile)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	var (
+		_      = flag.String("config", configFile, "YAML soubor s nastavením (viz config.example.yaml)")
+		dbPath = flag.String("db", cfg.Database.Path, "Cesta k SQLite databázi")
+		listen = flag.String("listen", cfg.Server.Listen, "Adresa serveru, např. :8080")
+		cors   = flag.String("cors", strings.Join(cfg.Server.CORSOrigins, ","), "Povolené CORS originy oddělené čárkami (* = všechny)")
+	)
+	flag.Parse()
+
+	cfg.Database.Path = *dbPath
+	cfg.Server.Listen = *listen
+	cfg.Server.CORSOrigins = nil
+	for _, origin := range strings.Split(*cors, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			cfg.Server.CORSOrigins = append(cfg.Server.CORSOrigins, origin)
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("❌ Invalid configuration:\n%v", err)
+	}
+	if configFile != "" {
+		log.Printf("📄 Configuration loaded from %s", configFile)
 	}
 
 	log.Printf("🔧 Connecting to database...")
-	db, err := database.NewDatabase("torrents.db")
+	db, err := database.NewDatabase(cfg.Database.Path)
 	if err != nil {
 		log.Fatalf("❌ Failed to connect to database: %v", err)
 	}
@@ -31,7 +59,11 @@ func main() {
 	}()
 	log.Printf("✅ Database connected successfully")
 
-	resolver := &graphql.Resolver{DB: db}
+	// Trackery pro magnet odkazy
+	resolver := &graphql.Resolver{
+		DB:       db,
+		Trackers: magnet.ParseTrackers(strings.Join(cfg.Trackers, ",")),
+	}
 
 	// Vytvoření GraphQL serveru s výchozí konfigurací (introspection povolena)
 	srv := handler.NewDefaultServer(graphql.NewExecutableSchema(graphql.Config{Resolvers: resolver}))
@@ -39,16 +71,23 @@ func main() {
 	// CORS middleware
 	corsMiddleware := func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.Header().Set("Access-Control-Allow-Origin", "*")
-			w.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+			if origin := allowedOrigin(cfg.Server.CORSOrigins, r.Header.Get("Origin")); origin != "" {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+				if origin != "*" {
+					w.Header().Add("Vary", "Origin")
+				}
+				w.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS")
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+			}
 
 			if r.Method == "OPTIONS" {
 				w.WriteHeader(http.StatusOK)
 				return
 			}
 
-			log.Printf("📡 %s %s", r.Method, r.URL.Path)
+			if cfg.Log.Requests {
+				log.Printf("📡 %s %s", r.Method, r.URL.Path)
+			}
 			next.ServeHTTP(w, r)
 		})
 	}
@@ -57,8 +96,26 @@ func main() {
 	http.Handle("/", playground.Handler("SkTorrent GraphQL Playground", "/query"))
 	http.Handle("/query", corsMiddleware(srv))
 
-	log.Printf("🚀 SkTorrent GraphQL server starting on port %s", port)
-	log.Printf("📖 GraphQL Playground: http://localhost:%s/", port)
-	log.Printf("🔗 GraphQL Endpoint: http://localhost:%s/query", port)
-	log.Fatal(http.ListenAndServe(":"+port, nil))
+	base := "http://localhost" + cfg.Server.Listen
+	if !strings.HasPrefix(cfg.Server.Listen, ":") {
+		base = "http://" + cfg.Server.Listen
+	}
+	log.Printf("🚀 SkTorrent GraphQL server starting on %s", cfg.Server.Listen)
+	log.Printf("📖 GraphQL Playground: %s/", base)
+	log.Printf("🔗 GraphQL Endpoint: %s/query", base)
+	log.Fatal(http.ListenAndServe(cfg.Server.Listen, nil))
+}
+
+// allowedOrigin vrátí hodnotu Access-Control-Allow-Origin pro požadavek,
+// nebo "", když origin povolený není
+func allowedOrigin(allowed []string, origin string) string {
+	for _, a := range allowed {
+		if a == "*" {
+			return "*"
+		}
+		if origin != "" && strings.EqualFold(a, origin) {
+			return origin
+		}
+	}
+	return ""
 }
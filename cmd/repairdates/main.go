@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/database"
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/parser"
+)
+
+// Jednorázová oprava dat přidání uložených starým parserem: "02/07/2025"
+// se ukládalo jako půlnoc UTC a nečitelné datum jako čas stažení.
+// Viz Database.RepairAddedDates.
+func main() {
+	var (
+		dbPath = flag.String("db", "torrents.db", "Cesta k SQLite databázi")
+		dryRun = flag.Bool("dry-run", false, "Jen spočítat, co by se změnilo")
+	)
+	flag.Parse()
+
+	db, err := database.NewDatabase(*dbPath)
+	if err != nil {
+		log.Fatalf("❌ Chyba při otevírání databáze: %v", err)
+	}
+	defer db.Close()
+
+	result, err := db.RepairAddedDates(context.Background(), parser.Location, *dryRun)
+	if err != nil {
+		log.Fatalf("❌ Oprava dat přidání selhala: %v", err)
+	}
+
+	if *dryRun {
+		fmt.Println("🧪 Dry run, nic se neuložilo")
+	}
+	fmt.Printf("🔍 Kontrolováno torrentů: %d\n", result.Checked)
+	fmt.Printf("🕐 Přepočteno na %s: %d\n", parser.Location, result.Shifted)
+	fmt.Printf("🧹 Smyšlené datum nahrazeno NULL: %d\n", result.Cleared)
+}
@@ -0,0 +1,322 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/archive"
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/config"
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/database"
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/parser"
+)
+
+// batchSize je počet torrentů v jedné transakci při ukládání
+const batchSize = 1000
+
+// observed je nejnovější verze torrentu nalezená v archivu
+type observed struct {
+	listing   parser.Listing
+	firstSeen time.Time // první výpis, ve kterém se torrent objevil
+	detail    *parser.Detail
+	detailAt  time.Time
+	detailURL string
+}
+
+// archiveStats počítá přečtené stránky archivu
+type archiveStats struct {
+	listingPages int
+	detailPages  int
+	emptyPages   int // výpisy bez torrentů
+	badPages     int // stránky, které parser nepřečetl
+}
+
+// Přepočet dat z archivu stažených stránek (crawler -archive) aktuálním
+// parserem, bez přístupu k síti. Archiv se projde od nejstarší verze po
+// nejnovější, takže u každého torrentu vyhraje poslední stažená podoba;
+// stats (seeds/leeches) se nepřepisují.
+func main() {
+	// Nastavení ze souboru a prostředí jsou výchozí hodnoty parametrů
+	configFile := config.PathFromArgs(os.Args[1:])
+	cfg, err := config.Load(configFile)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	var (
+		_           = flag.String("config", configFile, "YAML soubor s nastavením (viz config.example.yaml)")
+		dbPath      = flag.String("db", cfg.Database.Path, "Cesta k SQLite databázi")
+		archivePath = flag.String("archive", cfg.Crawler.Archive, "Archiv stažených stránek (crawler -archive)")
+		dryRun      = flag.Bool("dry-run", false, "Jen přečíst archiv a spočítat, co by se uložilo")
+	)
+	flag.Parse()
+
+	cfg.Database.Path = *dbPath
+	cfg.Crawler.Archive = *archivePath
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("❌ Neplatné nastavení:\n%v", err)
+	}
+	if cfg.Crawler.Archive == "" {
+		log.Fatal("❌ Chybí archiv, zadej -archive=soubor")
+	}
+	// Neexistující archiv by se jen založil prázdný
+	if _, err := os.Stat(cfg.Crawler.Archive); err != nil {
+		log.Fatalf("❌ Archiv nelze otevřít: %v", err)
+	}
+
+	pages, err := archive.Open(cfg.Crawler.Archive)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	defer pages.Close()
+
+	ctx := context.Background()
+	startTime := time.Now()
+	torrents, stats, err := readArchive(ctx, pages)
+	if err != nil {
+		log.Fatalf("❌ Čtení archivu selhalo: %v", err)
+	}
+
+	fmt.Printf("🗄️  Archiv: %d stránek výpisu (%d prázdných), %d detailů, %d nečitelných\n",
+		stats.listingPages, stats.emptyPages, stats.detailPages, stats.badPages)
+
+	db, err := database.NewDatabase(cfg.Database.Path)
+	if err != nil {
+		log.Fatalf("❌ Chyba při otevírání databáze: %v", err)
+	}
+	defer db.Close()
+
+	result, err := save(ctx, db, torrents, *dryRun)
+	if err != nil {
+		log.Fatalf("❌ Ukládání selhalo: %v", err)
+	}
+
+	if *dryRun {
+		fmt.Println("🧪 Dry run, nic se neuložilo")
+	}
+	fmt.Printf("📊 Torrentů v archivu: %d (v databázi už %d)\n", result.listed, result.known)
+	fmt.Printf("💾 Uloženo torrentů: %d, detailů: %d\n", result.torrents, result.details)
+	if result.unlisted > 0 {
+		fmt.Printf("📎 Z toho detailů torrentů, které jsou jen v databázi (ne ve výpisech archivu): %d\n", result.unlisted)
+	}
+	if len(result.orphans) > 0 {
+		fmt.Printf("🔎 Detaily torrentů, které nejsou ve výpisech archivu ani v databázi, se neuložily: %d\n", len(result.orphans))
+		for i, detailURL := range result.orphans {
+			if i == maxOrphansShown {
+				fmt.Printf("   ... a dalších %d\n", len(result.orphans)-i)
+				break
+			}
+			fmt.Printf("   %s\n", detailURL)
+		}
+	}
+	if result.failed > 0 {
+		fmt.Printf("❌ Chyby při ukládání: %d\n", result.failed)
+	}
+	fmt.Printf("⏱️  Celkový čas: %v\n", time.Since(startTime).Round(time.Millisecond))
+}
+
+// maxOrphansShown je počet vypsaných URL detailů bez torrentu
+const maxOrphansShown = 20
+
+// readArchive projde archiv od nejstarší verze po nejnovější a vrátí
+// poslední podobu každého torrentu podle ID
+func readArchive(ctx context.Context, pages *archive.Archive) (map[string]*observed, archiveStats, error) {
+	torrents := make(map[string]*observed)
+	var stats archiveStats
+
+	err := pages.Each(ctx, "", func(p archive.Page) error {
+		switch p.Kind {
+		case archive.KindListing:
+			stats.listingPages++
+			// Relativní data ("dnes 14:35") platí k okamžiku stažení
+			page, err := parser.ParseListingPage(bytes.NewReader(p.Body), p.FetchedAt.In(parser.Location))
+			if err != nil {
+				stats.badPages++
+				fmt.Printf("⚠️  %s (%s): %v\n", p.URL, p.FetchedAt.Format("02.01.2006 15:04"), err)
+				return nil
+			}
+			if len(page.Listings) == 0 {
+				stats.emptyPages++
+			}
+			for _, l := range page.Listings {
+				if l.ID == "" {
+					continue
+				}
+				o, ok := torrents[l.ID]
+				if !ok {
+					o = &observed{firstSeen: p.FetchedAt}
+					torrents[l.ID] = o
+				}
+				o.listing = l
+			}
+		case archive.KindDetail:
+			stats.detailPages++
+			id := detailID(p.URL)
+			if id == "" {
+				return nil
+			}
+			detail, err := parser.ParseDetail(bytes.NewReader(p.Body))
+			if err != nil {
+				stats.badPages++
+				fmt.Printf("⚠️  %s (%s): %v\n", p.URL, p.FetchedAt.Format("02.01.2006 15:04"), err)
+				return nil
+			}
+			o, ok := torrents[id]
+			if !ok {
+				o = &observed{}
+				torrents[id] = o
+			}
+			o.detail, o.detailAt, o.detailURL = &detail, p.FetchedAt, p.URL
+		}
+		return nil
+	})
+	return torrents, stats, err
+}
+
+// saveResult shrnuje zápis do databáze
+type saveResult struct {
+	listed   int // torrenty z výpisů
+	known    int // z nich už v databázi
+	torrents int
+	details  int
+	unlisted int      // uložené detaily torrentů, které jsou jen v databázi
+	orphans  []string // URL detailů torrentů, které nejsou ve výpisech ani v databázi
+	failed   int
+}
+
+// save zapíše torrenty a detaily po dávkách seřazených podle ID, aby dva
+// přepočty stejného archivu zapisovaly ve stejném pořadí. Torrentům bez
+// archivovaného detailu ponechá ČSFD odkaz z databáze. Detail torrentu,
+// který není ve výpisech archivu, se uloží, jen když torrent už je
+// v databázi; ostatní vrátí v saveResult.orphans.
+func save(ctx context.Context, db *database.Database, torrents map[string]*observed, dryRun bool) (saveResult, error) {
+	var result saveResult
+	ids := make([]string, 0, len(torrents))
+	for id := range torrents {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	for from := 0; from < len(ids); from += batchSize {
+		batch := ids[from:min(from+batchSize, len(ids))]
+		known, err := db.GetKnownTorrents(ctx, batch)
+		if err != nil {
+			return result, err
+		}
+
+		var rows []database.Torrent
+		var details []database.TorrentDetails
+		for _, id := range batch {
+			o := torrents[id]
+			k, isKnown := known[id]
+			if o.listing.ID == "" && !isKnown {
+				// Detail bez torrentu by porušil cizí klíč torrent_details
+				result.orphans = append(result.orphans, o.detailURL)
+				continue
+			}
+			if o.listing.ID != "" {
+				result.listed++
+				if isKnown {
+					result.known++
+				}
+				rows = append(rows, dbTorrent(o, k))
+			}
+			if o.detail != nil {
+				details = append(details, dbDetails(id, o))
+				if o.listing.ID == "" {
+					result.unlisted++
+				}
+			}
+		}
+		if dryRun {
+			result.torrents += len(rows)
+			result.details += len(details)
+			continue
+		}
+
+		err = db.InTx(ctx, func(tx *database.Tx) error {
+			saved, err := tx.SaveBatch(ctx, rows, nil)
+			if err != nil {
+				return err
+			}
+			result.torrents += saved.Torrents
+			result.failed += len(saved.Failed)
+			for _, rowErr := range saved.Failed {
+				fmt.Printf("⚠️  %v\n", rowErr)
+			}
+			for i := range details {
+				if err := tx.UpsertTorrentDetails(ctx, &details[i]); err != nil {
+					result.failed++
+					fmt.Printf("⚠️  Detail %s: %v\n", details[i].TorrentID, err)
+					continue
+				}
+				result.details++
+			}
+			return nil
+		})
+		if err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// dbTorrent převede torrent z archivu na database.Torrent
+func dbTorrent(o *observed, known database.KnownTorrent) database.Torrent {
+	l := o.listing
+	t := database.Torrent{
+		ID:         l.ID,
+		Name:       l.Name,
+		Category:   l.Category,
+		SizeMB:     l.SizeMB,
+		AddedDate:  l.AddedDate,
+		AddedRaw:   l.AddedDateRaw,
+		URL:        l.URL,
+		ImageURL:   l.ImageURL,
+		CSFDRating: l.CSFDRating,
+		CSFDURL:    known.CSFDURL,
+		CreatedAt:  o.firstSeen,
+	}
+	if o.detail != nil && o.detail.CSFDURL != "" {
+		t.CSFDURL = o.detail.CSFDURL
+	}
+	return t
+}
+
+// dbDetails převede detail z archivu na database.TorrentDetails
+func dbDetails(id string, o *observed) database.TorrentDetails {
+	d := o.detail
+	infoHash := d.InfoHash
+	if infoHash == "" {
+		// ID z URL je samo o sobě info-hash
+		infoHash = id
+	}
+	return database.TorrentDetails{
+		TorrentID:      id,
+		Description:    d.Description,
+		Uploader:       d.Uploader,
+		FileCount:      d.FileCount,
+		CompletedCount: d.CompletedCount,
+		IMDbURL:        d.IMDbURL,
+		TrailerURL:     d.TrailerURL,
+		Audio:          d.Audio,
+		Subtitles:      d.Subtitles,
+		InfoHash:       infoHash,
+		FetchedAt:      o.detailAt,
+	}
+}
+
+// detailID vrátí ID torrentu z URL detail stránky (details.php?...&id=...)
+func detailID(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("id")
+}
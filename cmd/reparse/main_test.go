@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/archive"
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/database"
+)
+
+const (
+	listingURL = "https://sktorrent.eu/torrent/torrents_v2.php?active=0&page=0"
+	// Ironheart z listing.html, detail.html je jeho detail
+	listedID = "b7616f2e4cef22d673ccf816fbcdf1097dda3e65"
+	// Torrent, který je jen v databázi
+	dbOnlyID = "1111111111111111111111111111111111111111"
+)
+
+func detailURL(id string) string {
+	return "https://sktorrent.eu/torrent/details.php?name=x&id=" + id
+}
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("..", "..", "internal", "parser", "testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+// testArchive založí archiv s jedním výpisem a detaily torrentu z výpisu,
+// torrentu jen v databázi a torrentů orphans, které nejsou nikde
+func testArchive(t *testing.T, orphans ...string) *archive.Archive {
+	t.Helper()
+	ctx := context.Background()
+	pages, err := archive.Open(filepath.Join(t.TempDir(), "archive.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { pages.Close() })
+
+	t0 := time.Date(2025, 6, 20, 10, 0, 0, 0, time.UTC)
+	detail := readFixture(t, "detail.html")
+	stores := []struct {
+		url  string
+		body []byte
+	}{
+		{listingURL, readFixture(t, "listing.html")},
+		{detailURL(listedID), detail},
+		{detailURL(dbOnlyID), readFixture(t, "detail_cz.html")},
+	}
+	for _, id := range orphans {
+		stores = append(stores, struct {
+			url  string
+			body []byte
+		}{detailURL(id), detail})
+	}
+	for i, s := range stores {
+		if _, err := pages.Store(ctx, s.url, t0.Add(time.Duration(i)*time.Minute), s.body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return pages
+}
+
+func testDB(t *testing.T) *database.Database {
+	t.Helper()
+	db, err := database.NewDatabase(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	err = db.UpsertTorrent(context.Background(), &database.Torrent{
+		ID:   dbOnlyID,
+		Name: "Lilo & Stitch 2025 CZ",
+		URL:  detailURL(dbOnlyID),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestReparse(t *testing.T) {
+	ctx := context.Background()
+	// Sirotci jsou v archivu v opačném pořadí, než je save vypíše
+	orphanIDs := []string{
+		"ffffffffffffffffffffffffffffffffffffffff",
+		"eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee",
+	}
+	pages := testArchive(t, orphanIDs...)
+
+	torrents, stats, err := readArchive(ctx, pages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStats := archiveStats{listingPages: 1, detailPages: 4}
+	if stats != wantStats {
+		t.Errorf("archive stats = %+v, want %+v", stats, wantStats)
+	}
+
+	wantOrphans := []string{detailURL(orphanIDs[1]), detailURL(orphanIDs[0])}
+	wantResult := saveResult{
+		listed:   8,
+		torrents: 8,
+		details:  2,
+		unlisted: 1,
+		orphans:  wantOrphans,
+	}
+	checkResult := func(name string, got, want saveResult) {
+		t.Helper()
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: result = %+v, want %+v", name, got, want)
+		}
+	}
+
+	db := testDB(t)
+
+	// Dry run spočítá totéž, ale nic nezapíše
+	result, err := save(ctx, db, torrents, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkResult("dry run", result, wantResult)
+	known, err := db.GetKnownTorrents(ctx, []string{listedID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(known) != 0 {
+		t.Errorf("dry run saved torrent %s", listedID)
+	}
+
+	result, err = save(ctx, db, torrents, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkResult("save", result, wantResult)
+
+	known, err = db.GetKnownTorrents(ctx, []string{listedID, dbOnlyID, orphanIDs[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(known) != 2 {
+		t.Errorf("known torrents = %d, want 2", len(known))
+	}
+	if k := known[listedID]; k.CSFDURL != "https://www.csfd.cz/film/1250706-ironheart/" || !k.HasDetails {
+		t.Errorf("torrent %s = %+v, want ČSFD URL from detail and details", listedID, k)
+	}
+	if !known[dbOnlyID].HasDetails {
+		t.Errorf("torrent %s has no details", dbOnlyID)
+	}
+
+	details, err := db.GetTorrentDetails(ctx, listedID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if details == nil || details.Uploader != "seriallover" || details.InfoHash != listedID {
+		t.Errorf("details of %s = %+v", listedID, details)
+	}
+	details, err = db.GetTorrentDetails(ctx, orphanIDs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if details != nil {
+		t.Errorf("orphan detail saved: %+v", details)
+	}
+
+	// Druhý přepočet už všechny torrenty z výpisu zná
+	result, err = save(ctx, db, torrents, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResult.known = 8
+	checkResult("second save", result, wantResult)
+}
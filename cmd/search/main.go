@@ -1,18 +1,34 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/config"
 	"github.com/JaLe29/search-me-plz-sktorrent/internal/database"
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/magnet"
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/parser"
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/release"
 )
 
 func main() {
+	// Nastavení ze souboru a prostředí jsou výchozí hodnoty parametrů
+	configFile := config.PathFromArgs(os.Args[1:])
+	cfg, err := config.Load(configFile)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
 	// Definice příkazových parametrů
 	var (
-		dbPath       = flag.String("db", "torrents.db", "Cesta k SQLite databázi")
+		_            = flag.String("config", configFile, "YAML soubor s nastavením (viz config.example.yaml)")
+		dbPath       = flag.String("db", cfg.Database.Path, "Cesta k SQLite databázi")
 		query        = flag.String("q", "", "Vyhledávací dotaz (název nebo kategorie)")
 		category     = flag.String("category", "", "Filtrovat podle kategorie")
 		recent       = flag.Bool("recent", false, "Zobrazit nejnovější torrenty")
@@ -20,10 +36,20 @@ func main() {
 		stats        = flag.Bool("stats", false, "Zobrazit statistiky databáze")
 		history      = flag.String("history", "", "Zobrazit historii stats pro torrent ID")
 		historyLimit = flag.Int("history-limit", 50, "Počet historických záznamů")
+		changes      = flag.String("changes", "", "Zobrazit historii změn údajů torrentu (název, kategorie, velikost, ČSFD)")
+		runs         = flag.Bool("runs", false, "Zobrazit historii běhů crawleru")
+		id           = flag.String("id", "", "Zobrazit torrent podle ID (info-hash)")
+		magnetOnly   = flag.Bool("magnet", false, "Vypsat jen magnet odkazy (jeden na řádek)")
+		trackers     = flag.String("trackers", strings.Join(cfg.Trackers, ","), "Trackery pro magnet odkazy oddělené čárkami")
 	)
 	flag.Parse()
 
-	if *query == "" && *category == "" && !*recent && !*stats && *history == "" {
+	cfg.Database.Path = *dbPath
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("❌ Neplatné nastavení:\n%v", err)
+	}
+
+	if *query == "" && *category == "" && !*recent && !*stats && *history == "" && *changes == "" && !*runs && *id == "" {
 		fmt.Println("🔍 SkTorrent Search")
 		fmt.Println("Použití:")
 		fmt.Println("  -q \"text\"          Vyhledat podle názvu")
@@ -31,9 +57,15 @@ func main() {
 		fmt.Println("  -recent            Zobrazit nejnovější")
 		fmt.Println("  -stats             Zobrazit statistiky")
 		fmt.Println("  -history \"id\"      Zobrazit historii stats pro torrent")
+		fmt.Println("  -changes \"id\"      Zobrazit historii změn názvu, kategorie, velikosti a ČSFD")
+		fmt.Println("  -runs              Zobrazit historii běhů crawleru")
+		fmt.Println("  -id \"hash\"         Zobrazit torrent podle ID")
+		fmt.Println("  -magnet            Vypsat jen magnet odkazy (např. pro torrent klienta)")
+		fmt.Println("  -trackers a,b      Trackery pro magnet odkazy (default: veřejné trackery)")
 		fmt.Println("  -limit N           Počet výsledků (default: 20)")
 		fmt.Println("  -history-limit N   Počet historických záznamů (default: 50)")
 		fmt.Println("  -db path           Cesta k databázi (default: torrents.db)")
+		fmt.Println("  -config soubor     YAML soubor s nastavením (databáze, trackery)")
 		fmt.Println()
 		fmt.Println("Příklady:")
 		fmt.Println("  ./search -q \"john wick\"")
@@ -41,11 +73,13 @@ func main() {
 		fmt.Println("  ./search -recent")
 		fmt.Println("  ./search -stats")
 		fmt.Println("  ./search -history \"abc123...\"")
+		fmt.Println("  ./search -changes \"abc123...\"")
+		fmt.Println("  ./search -id \"abc123...\" -magnet | xargs transmission-remote -a")
 		return
 	}
 
 	// Inicializace databáze
-	db, err := database.NewDatabase(*dbPath)
+	db, err := database.NewDatabase(cfg.Database.Path)
 	if err != nil {
 		log.Fatalf("❌ Chyba při připojení k databázi: %v", err)
 	}
@@ -57,16 +91,36 @@ func main() {
 		return
 	}
 
+	// Zobrazení historie běhů crawleru
+	if *runs {
+		showCrawlRuns(db, *limit)
+		return
+	}
+
 	// Zobrazení historie stats
 	if *history != "" {
 		showStatsHistory(db, *history, *historyLimit)
 		return
 	}
 
+	// Zobrazení historie změn údajů
+	if *changes != "" {
+		showChanges(db, *changes, *historyLimit)
+		return
+	}
+
+	trackerList := magnet.ParseTrackers(*trackers)
+
 	var torrents []database.TorrentWithStats
 
 	// Vyhledávání podle parametrů
-	if *query != "" {
+	if *id != "" {
+		var torrent *database.TorrentWithStats
+		torrent, err = db.GetTorrentWithCurrentStats(*id)
+		if err == nil {
+			torrents = []database.TorrentWithStats{*torrent}
+		}
+	} else if *query != "" {
 		fmt.Printf("🔍 Vyhledávám: \"%s\"\n", *query)
 		torrents, err = db.SearchTorrents(*query, *limit)
 	} else if *category != "" {
@@ -81,6 +135,22 @@ func main() {
 		log.Fatalf("❌ Chyba při vyhledávání: %v", err)
 	}
 
+	// Jen magnet odkazy, aby šel výstup rovnou poslat do torrent klienta
+	if *magnetOnly {
+		for _, torrent := range torrents {
+			uri, err := magnet.URI(torrent.ID, torrent.Name, trackerList)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "⚠️  %s: %v\n", torrent.ID, err)
+				continue
+			}
+			fmt.Println(uri)
+		}
+		if len(torrents) == 0 {
+			os.Exit(1)
+		}
+		return
+	}
+
 	if len(torrents) == 0 {
 		fmt.Println("❌ Žádné výsledky nenalezeny")
 		return
@@ -94,7 +164,10 @@ func main() {
 		fmt.Printf("    🆔 ID: %s\n", torrent.ID)
 		fmt.Printf("    🏷️  Kategorie: %s\n", torrent.Category)
 		fmt.Printf("    📦 Velikost: %.1f MB\n", torrent.SizeMB)
-		fmt.Printf("    📅 Přidáno na web: %s\n", torrent.AddedDate.Format("02.01.2006"))
+		if summary := releaseSummary(torrent.Release); summary != "" {
+			fmt.Printf("    🎞️  Release: %s\n", summary)
+		}
+		fmt.Printf("    📅 Přidáno na web: %s\n", formatAddedDate(torrent.AddedDate, torrent.AddedRaw))
 		fmt.Printf("    🌱 Seeders: %d | 🩸 Leechers: %d\n", torrent.Seeds, torrent.Leeches)
 
 		if torrent.CSFDRating != 0 {
@@ -112,11 +185,40 @@ func main() {
 		fmt.Printf("    🔗 URL: %s\n", torrent.URL)
 		fmt.Printf("    📅 Přidáno do DB: %s\n", torrent.CreatedAt.Format("02.01.2006 15:04"))
 		fmt.Printf("    🔄 Aktualizováno: %s\n", torrent.UpdatedAt.Format("02.01.2006 15:04"))
-		fmt.Printf("    💡 Historie: ./search -history \"%s\"\n", torrent.ID)
+		if *id != "" {
+			if uri, err := magnet.URI(torrent.ID, torrent.Name, trackerList); err == nil {
+				fmt.Printf("    🧲 Magnet: %s\n", uri)
+			}
+		}
+		fmt.Printf("    💡 Historie: ./search -history \"%s\" | změny: ./search -changes \"%s\"\n", torrent.ID, torrent.ID)
 		fmt.Println("    " + strings.Repeat("─", 60))
 	}
 }
 
+// releaseSummary shrne údaje z názvu do jednoho řádku ("2017 · 2160p · HEVC · CZ/EN")
+func releaseSummary(r release.Info) string {
+	var parts []string
+	if r.Year != 0 {
+		parts = append(parts, strconv.Itoa(r.Year))
+	}
+	switch {
+	case r.Season != 0 && r.EpisodeFrom != 0 && r.EpisodeTo > r.EpisodeFrom:
+		parts = append(parts, fmt.Sprintf("S%02dE%02d-E%02d", r.Season, r.EpisodeFrom, r.EpisodeTo))
+	case r.Season != 0 && r.EpisodeFrom != 0:
+		parts = append(parts, fmt.Sprintf("S%02dE%02d", r.Season, r.EpisodeFrom))
+	case r.Season != 0 && r.SeasonTo > r.Season:
+		parts = append(parts, fmt.Sprintf("S%02d-S%02d", r.Season, r.SeasonTo))
+	case r.Season != 0:
+		parts = append(parts, fmt.Sprintf("S%02d", r.Season))
+	}
+	for _, part := range []string{r.Resolution, r.Source, r.Codec, r.HDR, strings.Join(r.Languages, "/"), r.Group} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, " · ")
+}
+
 func showStats(db *database.Database) {
 	stats, err := db.GetStats()
 	if err != nil {
@@ -169,7 +271,7 @@ func showStatsHistory(db *database.Database, torrentID string, limit int) {
 	fmt.Printf("🆔 ID: %s\n", torrent.ID)
 	fmt.Printf("🏷️  Kategorie: %s\n", torrent.Category)
 	fmt.Printf("📦 Velikost: %.1f MB\n", torrent.SizeMB)
-	fmt.Printf("📅 Přidáno na web: %s\n", torrent.AddedDate.Format("02.01.2006"))
+	fmt.Printf("📅 Přidáno na web: %s\n", formatAddedDate(torrent.AddedDate, torrent.AddedRaw))
 	fmt.Printf("🌱 Aktuální Seeders: %d | 🩸 Leechers: %d\n\n", torrent.Seeds, torrent.Leeches)
 
 	// Získáme historii
@@ -223,3 +325,110 @@ func showStatsHistory(db *database.Database, torrentID string, limit int) {
 		}
 	}
 }
+
+// changeFieldLabels jsou české popisky sledovaných údajů (torrent_changes.field)
+var changeFieldLabels = map[string]string{
+	"name":        "📺 Název",
+	"category":    "🏷️  Kategorie",
+	"size_mb":     "📦 Velikost (MB)",
+	"csfd_rating": "⭐ ČSFD hodnocení",
+	"csfd_url":    "🎬 ČSFD odkaz",
+}
+
+func showChanges(db *database.Database, torrentID string, limit int) {
+	torrent, err := db.GetTorrentWithCurrentStats(torrentID)
+	if err != nil {
+		log.Fatalf("❌ Torrent s ID '%s' nenalezen: %v", torrentID, err)
+	}
+
+	fmt.Printf("📝 HISTORIE ZMĚN TORRENTU\n")
+	fmt.Println(strings.Repeat("=", 50))
+	fmt.Printf("📺 %s\n", torrent.Name)
+	fmt.Printf("🆔 ID: %s\n", torrent.ID)
+	fmt.Printf("📅 Přidáno do DB: %s\n\n", torrent.CreatedAt.Format("02.01.2006 15:04"))
+
+	changes, err := db.GetTorrentChanges(context.Background(), torrentID, limit)
+	if err != nil {
+		log.Fatalf("❌ Chyba při získávání změn: %v", err)
+	}
+
+	if len(changes) == 0 {
+		fmt.Println("✅ Od prvního uložení se údaje nezměnily")
+		return
+	}
+
+	fmt.Printf("🔄 ZMĚNY (%d, od nejnovější):\n", len(changes))
+	for _, c := range changes {
+		label, ok := changeFieldLabels[c.Field]
+		if !ok {
+			label = c.Field
+		}
+		fmt.Printf("  %s  %s\n", c.ChangedAt.Format("02.01.06 15:04"), label)
+		fmt.Printf("      ➖ %s\n", changeValue(c.OldValue))
+		fmt.Printf("      ➕ %s\n", changeValue(c.NewValue))
+	}
+}
+
+// changeValue vypíše hodnotu ze změny; prázdná znamená, že údaj chyběl
+func changeValue(v string) string {
+	if v == "" {
+		return "(nevyplněno)"
+	}
+	return v
+}
+
+func showCrawlRuns(db *database.Database, limit int) {
+	runs, err := db.GetCrawlRuns(context.Background(), limit)
+	if err != nil {
+		log.Fatalf("❌ Chyba při získávání běhů crawleru: %v", err)
+	}
+
+	if len(runs) == 0 {
+		fmt.Println("❌ Žádné běhy crawleru nenalezeny")
+		return
+	}
+
+	fmt.Printf("🕷️  BĚHY CRAWLERU (%d):\n", len(runs))
+	fmt.Println("┌───────┬─────────────┬─────────────┬─────────────┬────────────────┬────────────────┬──────────┐")
+	fmt.Println("│ Běh   │ Druh        │ Stránky     │ Stav        │ Začátek        │ Konec          │ Torrenty │")
+	fmt.Println("├───────┼─────────────┼─────────────┼─────────────┼────────────────┼────────────────┼──────────┤")
+
+	for _, run := range runs {
+		finished := "-"
+		if run.FinishedAt != nil {
+			finished = run.FinishedAt.Format("02.01.06 15:04")
+		}
+		fmt.Printf("│ %-5d │ %-11s │ %5d-%-5d │ %-11s │ %-14s │ %-14s │ %8d │\n",
+			run.ID, run.Kind, run.FromPage, run.ToPage, run.Status,
+			run.StartedAt.Format("02.01.06 15:04"), finished, run.Torrents)
+	}
+	fmt.Println("└───────┴─────────────┴─────────────┴─────────────┴────────────────┴────────────────┴──────────┘")
+
+	for _, run := range runs {
+		if run.Error != "" {
+			fmt.Printf("⚠️  Běh #%d (%s): %s\n", run.ID, run.Status, run.Error)
+		}
+	}
+	for _, run := range runs {
+		if run.PagesDone < run.PagesTotal && run.Status != database.RunDone && run.Status != database.RunCaughtUp {
+			fmt.Printf("💡 Běh #%d: hotovo %d/%d stránek, pokračovat: ./crawler -resume %d\n",
+				run.ID, run.PagesDone, run.PagesTotal, run.ID)
+		}
+	}
+}
+
+// formatAddedDate zobrazí datum přidání v časovém pásmu sktorrentu; čas
+// jen když ho stránka uváděla
+func formatAddedDate(added time.Time, raw string) string {
+	if added.IsZero() {
+		if raw != "" {
+			return fmt.Sprintf("neznámé (%q)", raw)
+		}
+		return "neznámé"
+	}
+	local := added.In(parser.Location)
+	if local.Hour() == 0 && local.Minute() == 0 && local.Second() == 0 {
+		return local.Format("02.01.2006")
+	}
+	return local.Format("02.01.2006 15:04")
+}
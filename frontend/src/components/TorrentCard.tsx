@@ -38,8 +38,12 @@ export const TorrentCard: React.FC<TorrentCardProps> = ({ torrent }) => {
     };
   };
 
-  const formatDate = (dateString: string): string => {
-    return new Date(dateString).toLocaleDateString('cs-CZ');
+  const formatDate = (dateString: string | null, raw?: string | null): string => {
+    if (dateString) {
+      return new Date(dateString).toLocaleDateString('cs-CZ');
+    }
+    // Datum se nepodařilo přečíst, ukážeme aspoň text ze sktorrentu
+    return raw || 'Neznámé datum';
   };
 
   const shouldShowRating = (torrent.csfdRating ?? 0) > 0;
@@ -84,7 +88,7 @@ export const TorrentCard: React.FC<TorrentCardProps> = ({ torrent }) => {
           <div className="torrent-card-date">
             <Text className="torrent-card-date-text">
               <CalendarOutlined style={{ marginRight: 6 }} />
-              {formatDate(torrent.addedDate)}
+              {formatDate(torrent.addedDate, torrent.addedDateRaw)}
             </Text>
           </div>
 
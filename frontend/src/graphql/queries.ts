@@ -21,6 +21,7 @@ export const GET_TORRENTS = gql`
         category
         sizeMB
         addedDate
+        addedDateRaw
         url
         imageURL
         csfdRating
@@ -45,6 +46,7 @@ export const GET_RECENT_TORRENTS = gql`
       category
       sizeMB
       addedDate
+      addedDateRaw
       url
       imageURL
       csfdRating
@@ -65,6 +67,7 @@ export const SEARCH_TORRENTS = gql`
       category
       sizeMB
       addedDate
+      addedDateRaw
       url
       imageURL
       csfdRating
@@ -107,6 +110,7 @@ export const GET_TORRENTS_BY_CSFD_ID = gql`
       category
       sizeMB
       addedDate
+      addedDateRaw
       url
       imageURL
       csfdRating
@@ -3,7 +3,8 @@ export interface Torrent {
   name: string;
   category: string;
   sizeMB: number;
-  addedDate: string;
+  addedDate: string | null;
+  addedDateRaw?: string | null;
   url: string;
   imageURL?: string;
   csfdRating?: number;
@@ -6,6 +6,7 @@ require (
 	github.com/99designs/gqlgen v0.17.76
 	github.com/PuerkitoBio/goquery v1.10.3
 	github.com/vektah/gqlparser/v2 v2.5.30
+	gopkg.in/yaml.v3 v3.0.1
 	modernc.org/sqlite v1.38.0
 )
 
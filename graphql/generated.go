@@ -40,6 +40,7 @@ type Config struct {
 
 type ResolverRoot interface {
 	Query() QueryResolver
+	Torrent() TorrentResolver
 }
 
 type DirectiveRoot struct {
@@ -63,25 +64,54 @@ type ComplexityRoot struct {
 		SearchTorrents     func(childComplexity int, query string, limit *int) int
 		Stats              func(childComplexity int) int
 		Torrent            func(childComplexity int, id string) int
-		Torrents           func(childComplexity int, first *int, after *string, category *string, search *string, sortBy *TorrentSortBy) int
+		Torrents           func(childComplexity int, first *int, after *string, category *string, search *string, sortBy *TorrentSortBy, release *ReleaseFilter) int
 		TorrentsByCategory func(childComplexity int, category string, limit *int) int
 		TorrentsByCsfdid   func(childComplexity int, csfdID string, limit *int) int
 	}
 
+	ReleaseInfo struct {
+		Codec         func(childComplexity int) int
+		Dubbed        func(childComplexity int) int
+		EpisodeFrom   func(childComplexity int) int
+		EpisodeTo     func(childComplexity int) int
+		Group         func(childComplexity int) int
+		Hdr           func(childComplexity int) int
+		Languages     func(childComplexity int) int
+		OriginalTitle func(childComplexity int) int
+		Resolution    func(childComplexity int) int
+		Season        func(childComplexity int) int
+		SeasonTo      func(childComplexity int) int
+		Source        func(childComplexity int) int
+		Title         func(childComplexity int) int
+		Year          func(childComplexity int) int
+	}
+
 	Torrent struct {
-		AddedDate  func(childComplexity int) int
-		Category   func(childComplexity int) int
-		CreatedAt  func(childComplexity int) int
-		CsfdRating func(childComplexity int) int
-		CsfdURL    func(childComplexity int) int
-		ID         func(childComplexity int) int
-		ImageURL   func(childComplexity int) int
-		Leeches    func(childComplexity int) int
-		Name       func(childComplexity int) int
-		Seeds      func(childComplexity int) int
-		SizeMb     func(childComplexity int) int
-		URL        func(childComplexity int) int
-		UpdatedAt  func(childComplexity int) int
+		AddedDate    func(childComplexity int) int
+		AddedDateRaw func(childComplexity int) int
+		Category     func(childComplexity int) int
+		Changes      func(childComplexity int, limit *int) int
+		CreatedAt    func(childComplexity int) int
+		CsfdRating   func(childComplexity int) int
+		CsfdURL      func(childComplexity int) int
+		Details      func(childComplexity int) int
+		ID           func(childComplexity int) int
+		ImageURL     func(childComplexity int) int
+		Leeches      func(childComplexity int) int
+		MagnetURI    func(childComplexity int) int
+		Name         func(childComplexity int) int
+		Release      func(childComplexity int) int
+		Seeds        func(childComplexity int) int
+		SizeMb       func(childComplexity int) int
+		URL          func(childComplexity int) int
+		UpdatedAt    func(childComplexity int) int
+	}
+
+	TorrentChange struct {
+		ChangedAt func(childComplexity int) int
+		Field     func(childComplexity int) int
+		NewValue  func(childComplexity int) int
+		OldValue  func(childComplexity int) int
 	}
 
 	TorrentConnection struct {
@@ -91,6 +121,19 @@ type ComplexityRoot struct {
 		TotalCount      func(childComplexity int) int
 	}
 
+	TorrentDetails struct {
+		Audio          func(childComplexity int) int
+		CompletedCount func(childComplexity int) int
+		Description    func(childComplexity int) int
+		FetchedAt      func(childComplexity int) int
+		FileCount      func(childComplexity int) int
+		ImdbURL        func(childComplexity int) int
+		InfoHash       func(childComplexity int) int
+		Subtitles      func(childComplexity int) int
+		TrailerURL     func(childComplexity int) int
+		Uploader       func(childComplexity int) int
+	}
+
 	TorrentStats struct {
 		ID         func(childComplexity int) int
 		Leeches    func(childComplexity int) int
@@ -102,7 +145,7 @@ type ComplexityRoot struct {
 
 type QueryResolver interface {
 	Torrent(ctx context.Context, id string) (*Torrent, error)
-	Torrents(ctx context.Context, first *int, after *string, category *string, search *string, sortBy *TorrentSortBy) (*TorrentConnection, error)
+	Torrents(ctx context.Context, first *int, after *string, category *string, search *string, sortBy *TorrentSortBy, release *ReleaseFilter) (*TorrentConnection, error)
 	RecentTorrents(ctx context.Context, limit *int) ([]*Torrent, error)
 	SearchTorrents(ctx context.Context, query string, limit *int) ([]*Torrent, error)
 	TorrentsByCategory(ctx context.Context, category string, limit *int) ([]*Torrent, error)
@@ -110,6 +153,12 @@ type QueryResolver interface {
 	Categories(ctx context.Context) ([]*Category, error)
 	Stats(ctx context.Context) (*DatabaseStats, error)
 }
+type TorrentResolver interface {
+	Details(ctx context.Context, obj *Torrent) (*TorrentDetails, error)
+	MagnetURI(ctx context.Context, obj *Torrent) (*string, error)
+
+	Changes(ctx context.Context, obj *Torrent, limit *int) ([]*TorrentChange, error)
+}
 
 type executableSchema struct {
 	schema     *ast.Schema
@@ -225,7 +274,7 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.complexity.Query.Torrents(childComplexity, args["first"].(*int), args["after"].(*string), args["category"].(*string), args["search"].(*string), args["sortBy"].(*TorrentSortBy)), true
+		return e.complexity.Query.Torrents(childComplexity, args["first"].(*int), args["after"].(*string), args["category"].(*string), args["search"].(*string), args["sortBy"].(*TorrentSortBy), args["release"].(*ReleaseFilter)), true
 
 	case "Query.torrentsByCategory":
 		if e.complexity.Query.TorrentsByCategory == nil {
@@ -251,6 +300,104 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.TorrentsByCsfdid(childComplexity, args["csfdID"].(string), args["limit"].(*int)), true
 
+	case "ReleaseInfo.codec":
+		if e.complexity.ReleaseInfo.Codec == nil {
+			break
+		}
+
+		return e.complexity.ReleaseInfo.Codec(childComplexity), true
+
+	case "ReleaseInfo.dubbed":
+		if e.complexity.ReleaseInfo.Dubbed == nil {
+			break
+		}
+
+		return e.complexity.ReleaseInfo.Dubbed(childComplexity), true
+
+	case "ReleaseInfo.episodeFrom":
+		if e.complexity.ReleaseInfo.EpisodeFrom == nil {
+			break
+		}
+
+		return e.complexity.ReleaseInfo.EpisodeFrom(childComplexity), true
+
+	case "ReleaseInfo.episodeTo":
+		if e.complexity.ReleaseInfo.EpisodeTo == nil {
+			break
+		}
+
+		return e.complexity.ReleaseInfo.EpisodeTo(childComplexity), true
+
+	case "ReleaseInfo.group":
+		if e.complexity.ReleaseInfo.Group == nil {
+			break
+		}
+
+		return e.complexity.ReleaseInfo.Group(childComplexity), true
+
+	case "ReleaseInfo.hdr":
+		if e.complexity.ReleaseInfo.Hdr == nil {
+			break
+		}
+
+		return e.complexity.ReleaseInfo.Hdr(childComplexity), true
+
+	case "ReleaseInfo.languages":
+		if e.complexity.ReleaseInfo.Languages == nil {
+			break
+		}
+
+		return e.complexity.ReleaseInfo.Languages(childComplexity), true
+
+	case "ReleaseInfo.originalTitle":
+		if e.complexity.ReleaseInfo.OriginalTitle == nil {
+			break
+		}
+
+		return e.complexity.ReleaseInfo.OriginalTitle(childComplexity), true
+
+	case "ReleaseInfo.resolution":
+		if e.complexity.ReleaseInfo.Resolution == nil {
+			break
+		}
+
+		return e.complexity.ReleaseInfo.Resolution(childComplexity), true
+
+	case "ReleaseInfo.season":
+		if e.complexity.ReleaseInfo.Season == nil {
+			break
+		}
+
+		return e.complexity.ReleaseInfo.Season(childComplexity), true
+
+	case "ReleaseInfo.seasonTo":
+		if e.complexity.ReleaseInfo.SeasonTo == nil {
+			break
+		}
+
+		return e.complexity.ReleaseInfo.SeasonTo(childComplexity), true
+
+	case "ReleaseInfo.source":
+		if e.complexity.ReleaseInfo.Source == nil {
+			break
+		}
+
+		return e.complexity.ReleaseInfo.Source(childComplexity), true
+
+	case "ReleaseInfo.title":
+		if e.complexity.ReleaseInfo.Title == nil {
+			break
+		}
+
+		return e.complexity.ReleaseInfo.Title(childComplexity), true
+
+	case "ReleaseInfo.year":
+		if e.complexity.ReleaseInfo.Year == nil {
+			break
+		}
+
+		return e.complexity.ReleaseInfo.Year(childComplexity), true
+
 	case "Torrent.addedDate":
 		if e.complexity.Torrent.AddedDate == nil {
 			break
@@ -258,6 +405,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Torrent.AddedDate(childComplexity), true
 
+	case "Torrent.addedDateRaw":
+		if e.complexity.Torrent.AddedDateRaw == nil {
+			break
+		}
+
+		return e.complexity.Torrent.AddedDateRaw(childComplexity), true
+
 	case "Torrent.category":
 		if e.complexity.Torrent.Category == nil {
 			break
@@ -265,6 +419,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Torrent.Category(childComplexity), true
 
+	case "Torrent.changes":
+		if e.complexity.Torrent.Changes == nil {
+			break
+		}
+
+		args, err := ec.field_Torrent_changes_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Torrent.Changes(childComplexity, args["limit"].(*int)), true
+
 	case "Torrent.createdAt":
 		if e.complexity.Torrent.CreatedAt == nil {
 			break
@@ -286,6 +452,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Torrent.CsfdURL(childComplexity), true
 
+	case "Torrent.details":
+		if e.complexity.Torrent.Details == nil {
+			break
+		}
+
+		return e.complexity.Torrent.Details(childComplexity), true
+
 	case "Torrent.id":
 		if e.complexity.Torrent.ID == nil {
 			break
@@ -307,6 +480,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Torrent.Leeches(childComplexity), true
 
+	case "Torrent.magnetURI":
+		if e.complexity.Torrent.MagnetURI == nil {
+			break
+		}
+
+		return e.complexity.Torrent.MagnetURI(childComplexity), true
+
 	case "Torrent.name":
 		if e.complexity.Torrent.Name == nil {
 			break
@@ -314,6 +494,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Torrent.Name(childComplexity), true
 
+	case "Torrent.release":
+		if e.complexity.Torrent.Release == nil {
+			break
+		}
+
+		return e.complexity.Torrent.Release(childComplexity), true
+
 	case "Torrent.seeds":
 		if e.complexity.Torrent.Seeds == nil {
 			break
@@ -342,6 +529,34 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Torrent.UpdatedAt(childComplexity), true
 
+	case "TorrentChange.changedAt":
+		if e.complexity.TorrentChange.ChangedAt == nil {
+			break
+		}
+
+		return e.complexity.TorrentChange.ChangedAt(childComplexity), true
+
+	case "TorrentChange.field":
+		if e.complexity.TorrentChange.Field == nil {
+			break
+		}
+
+		return e.complexity.TorrentChange.Field(childComplexity), true
+
+	case "TorrentChange.newValue":
+		if e.complexity.TorrentChange.NewValue == nil {
+			break
+		}
+
+		return e.complexity.TorrentChange.NewValue(childComplexity), true
+
+	case "TorrentChange.oldValue":
+		if e.complexity.TorrentChange.OldValue == nil {
+			break
+		}
+
+		return e.complexity.TorrentChange.OldValue(childComplexity), true
+
 	case "TorrentConnection.hasNextPage":
 		if e.complexity.TorrentConnection.HasNextPage == nil {
 			break
@@ -370,6 +585,76 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TorrentConnection.TotalCount(childComplexity), true
 
+	case "TorrentDetails.audio":
+		if e.complexity.TorrentDetails.Audio == nil {
+			break
+		}
+
+		return e.complexity.TorrentDetails.Audio(childComplexity), true
+
+	case "TorrentDetails.completedCount":
+		if e.complexity.TorrentDetails.CompletedCount == nil {
+			break
+		}
+
+		return e.complexity.TorrentDetails.CompletedCount(childComplexity), true
+
+	case "TorrentDetails.description":
+		if e.complexity.TorrentDetails.Description == nil {
+			break
+		}
+
+		return e.complexity.TorrentDetails.Description(childComplexity), true
+
+	case "TorrentDetails.fetchedAt":
+		if e.complexity.TorrentDetails.FetchedAt == nil {
+			break
+		}
+
+		return e.complexity.TorrentDetails.FetchedAt(childComplexity), true
+
+	case "TorrentDetails.fileCount":
+		if e.complexity.TorrentDetails.FileCount == nil {
+			break
+		}
+
+		return e.complexity.TorrentDetails.FileCount(childComplexity), true
+
+	case "TorrentDetails.imdbURL":
+		if e.complexity.TorrentDetails.ImdbURL == nil {
+			break
+		}
+
+		return e.complexity.TorrentDetails.ImdbURL(childComplexity), true
+
+	case "TorrentDetails.infoHash":
+		if e.complexity.TorrentDetails.InfoHash == nil {
+			break
+		}
+
+		return e.complexity.TorrentDetails.InfoHash(childComplexity), true
+
+	case "TorrentDetails.subtitles":
+		if e.complexity.TorrentDetails.Subtitles == nil {
+			break
+		}
+
+		return e.complexity.TorrentDetails.Subtitles(childComplexity), true
+
+	case "TorrentDetails.trailerURL":
+		if e.complexity.TorrentDetails.TrailerURL == nil {
+			break
+		}
+
+		return e.complexity.TorrentDetails.TrailerURL(childComplexity), true
+
+	case "TorrentDetails.uploader":
+		if e.complexity.TorrentDetails.Uploader == nil {
+			break
+		}
+
+		return e.complexity.TorrentDetails.Uploader(childComplexity), true
+
 	case "TorrentStats.id":
 		if e.complexity.TorrentStats.ID == nil {
 			break
@@ -412,7 +697,9 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	opCtx := graphql.GetOperationContext(ctx)
 	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
-	inputUnmarshalMap := graphql.BuildUnmarshalerMap()
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputReleaseFilter,
+	)
 	first := true
 
 	switch opCtx.Operation.Operation {
@@ -778,6 +1065,11 @@ func (ec *executionContext) field_Query_torrents_args(ctx context.Context, rawAr
 		return nil, err
 	}
 	args["sortBy"] = arg4
+	arg5, err := ec.field_Query_torrents_argsRelease(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["release"] = arg5
 	return args, nil
 }
 func (ec *executionContext) field_Query_torrents_argsFirst(
@@ -870,6 +1162,52 @@ func (ec *executionContext) field_Query_torrents_argsSortBy(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_torrents_argsRelease(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*ReleaseFilter, error) {
+	if _, ok := rawArgs["release"]; !ok {
+		var zeroVal *ReleaseFilter
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("release"))
+	if tmp, ok := rawArgs["release"]; ok {
+		return ec.unmarshalOReleaseFilter2ᚖgithubᚗcomᚋJaLe29ᚋsearchᚑmeᚑplzᚑsktorrentᚋgraphqlᚐReleaseFilter(ctx, tmp)
+	}
+
+	var zeroVal *ReleaseFilter
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Torrent_changes_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Torrent_changes_argsLimit(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Torrent_changes_argsLimit(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int, error) {
+	if _, ok := rawArgs["limit"]; !ok {
+		var zeroVal *int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+	if tmp, ok := rawArgs["limit"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -1262,6 +1600,8 @@ func (ec *executionContext) fieldContext_Query_torrent(ctx context.Context, fiel
 				return ec.fieldContext_Torrent_sizeMB(ctx, field)
 			case "addedDate":
 				return ec.fieldContext_Torrent_addedDate(ctx, field)
+			case "addedDateRaw":
+				return ec.fieldContext_Torrent_addedDateRaw(ctx, field)
 			case "url":
 				return ec.fieldContext_Torrent_url(ctx, field)
 			case "imageURL":
@@ -1278,6 +1618,14 @@ func (ec *executionContext) fieldContext_Query_torrent(ctx context.Context, fiel
 				return ec.fieldContext_Torrent_seeds(ctx, field)
 			case "leeches":
 				return ec.fieldContext_Torrent_leeches(ctx, field)
+			case "details":
+				return ec.fieldContext_Torrent_details(ctx, field)
+			case "magnetURI":
+				return ec.fieldContext_Torrent_magnetURI(ctx, field)
+			case "release":
+				return ec.fieldContext_Torrent_release(ctx, field)
+			case "changes":
+				return ec.fieldContext_Torrent_changes(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Torrent", field.Name)
 		},
@@ -1310,7 +1658,7 @@ func (ec *executionContext) _Query_torrents(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Torrents(rctx, fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["category"].(*string), fc.Args["search"].(*string), fc.Args["sortBy"].(*TorrentSortBy))
+		return ec.resolvers.Query().Torrents(rctx, fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["category"].(*string), fc.Args["search"].(*string), fc.Args["sortBy"].(*TorrentSortBy), fc.Args["release"].(*ReleaseFilter))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1410,6 +1758,8 @@ func (ec *executionContext) fieldContext_Query_recentTorrents(ctx context.Contex
 				return ec.fieldContext_Torrent_sizeMB(ctx, field)
 			case "addedDate":
 				return ec.fieldContext_Torrent_addedDate(ctx, field)
+			case "addedDateRaw":
+				return ec.fieldContext_Torrent_addedDateRaw(ctx, field)
 			case "url":
 				return ec.fieldContext_Torrent_url(ctx, field)
 			case "imageURL":
@@ -1426,6 +1776,14 @@ func (ec *executionContext) fieldContext_Query_recentTorrents(ctx context.Contex
 				return ec.fieldContext_Torrent_seeds(ctx, field)
 			case "leeches":
 				return ec.fieldContext_Torrent_leeches(ctx, field)
+			case "details":
+				return ec.fieldContext_Torrent_details(ctx, field)
+			case "magnetURI":
+				return ec.fieldContext_Torrent_magnetURI(ctx, field)
+			case "release":
+				return ec.fieldContext_Torrent_release(ctx, field)
+			case "changes":
+				return ec.fieldContext_Torrent_changes(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Torrent", field.Name)
 		},
@@ -1493,6 +1851,8 @@ func (ec *executionContext) fieldContext_Query_searchTorrents(ctx context.Contex
 				return ec.fieldContext_Torrent_sizeMB(ctx, field)
 			case "addedDate":
 				return ec.fieldContext_Torrent_addedDate(ctx, field)
+			case "addedDateRaw":
+				return ec.fieldContext_Torrent_addedDateRaw(ctx, field)
 			case "url":
 				return ec.fieldContext_Torrent_url(ctx, field)
 			case "imageURL":
@@ -1509,6 +1869,14 @@ func (ec *executionContext) fieldContext_Query_searchTorrents(ctx context.Contex
 				return ec.fieldContext_Torrent_seeds(ctx, field)
 			case "leeches":
 				return ec.fieldContext_Torrent_leeches(ctx, field)
+			case "details":
+				return ec.fieldContext_Torrent_details(ctx, field)
+			case "magnetURI":
+				return ec.fieldContext_Torrent_magnetURI(ctx, field)
+			case "release":
+				return ec.fieldContext_Torrent_release(ctx, field)
+			case "changes":
+				return ec.fieldContext_Torrent_changes(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Torrent", field.Name)
 		},
@@ -1576,6 +1944,8 @@ func (ec *executionContext) fieldContext_Query_torrentsByCategory(ctx context.Co
 				return ec.fieldContext_Torrent_sizeMB(ctx, field)
 			case "addedDate":
 				return ec.fieldContext_Torrent_addedDate(ctx, field)
+			case "addedDateRaw":
+				return ec.fieldContext_Torrent_addedDateRaw(ctx, field)
 			case "url":
 				return ec.fieldContext_Torrent_url(ctx, field)
 			case "imageURL":
@@ -1592,6 +1962,14 @@ func (ec *executionContext) fieldContext_Query_torrentsByCategory(ctx context.Co
 				return ec.fieldContext_Torrent_seeds(ctx, field)
 			case "leeches":
 				return ec.fieldContext_Torrent_leeches(ctx, field)
+			case "details":
+				return ec.fieldContext_Torrent_details(ctx, field)
+			case "magnetURI":
+				return ec.fieldContext_Torrent_magnetURI(ctx, field)
+			case "release":
+				return ec.fieldContext_Torrent_release(ctx, field)
+			case "changes":
+				return ec.fieldContext_Torrent_changes(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Torrent", field.Name)
 		},
@@ -1659,6 +2037,8 @@ func (ec *executionContext) fieldContext_Query_torrentsByCSFDID(ctx context.Cont
 				return ec.fieldContext_Torrent_sizeMB(ctx, field)
 			case "addedDate":
 				return ec.fieldContext_Torrent_addedDate(ctx, field)
+			case "addedDateRaw":
+				return ec.fieldContext_Torrent_addedDateRaw(ctx, field)
 			case "url":
 				return ec.fieldContext_Torrent_url(ctx, field)
 			case "imageURL":
@@ -1675,6 +2055,14 @@ func (ec *executionContext) fieldContext_Query_torrentsByCSFDID(ctx context.Cont
 				return ec.fieldContext_Torrent_seeds(ctx, field)
 			case "leeches":
 				return ec.fieldContext_Torrent_leeches(ctx, field)
+			case "details":
+				return ec.fieldContext_Torrent_details(ctx, field)
+			case "magnetURI":
+				return ec.fieldContext_Torrent_magnetURI(ctx, field)
+			case "release":
+				return ec.fieldContext_Torrent_release(ctx, field)
+			case "changes":
+				return ec.fieldContext_Torrent_changes(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Torrent", field.Name)
 		},
@@ -1926,8 +2314,8 @@ func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Torrent_id(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Torrent_id(ctx, field)
+func (ec *executionContext) _ReleaseInfo_title(ctx context.Context, field graphql.CollectedField, obj *ReleaseInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReleaseInfo_title(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1940,7 +2328,7 @@ func (ec *executionContext) _Torrent_id(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1954,24 +2342,24 @@ func (ec *executionContext) _Torrent_id(ctx context.Context, field graphql.Colle
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Torrent_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ReleaseInfo_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Torrent",
+		Object:     "ReleaseInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Torrent_name(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Torrent_name(ctx, field)
+func (ec *executionContext) _ReleaseInfo_originalTitle(ctx context.Context, field graphql.CollectedField, obj *ReleaseInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReleaseInfo_originalTitle(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1984,26 +2372,23 @@ func (ec *executionContext) _Torrent_name(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.OriginalTitle, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Torrent_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ReleaseInfo_originalTitle(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Torrent",
+		Object:     "ReleaseInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2014,8 +2399,8 @@ func (ec *executionContext) fieldContext_Torrent_name(_ context.Context, field g
 	return fc, nil
 }
 
-func (ec *executionContext) _Torrent_category(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Torrent_category(ctx, field)
+func (ec *executionContext) _ReleaseInfo_year(ctx context.Context, field graphql.CollectedField, obj *ReleaseInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReleaseInfo_year(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2028,38 +2413,35 @@ func (ec *executionContext) _Torrent_category(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Category, nil
+		return obj.Year, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Torrent_category(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ReleaseInfo_year(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Torrent",
+		Object:     "ReleaseInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Torrent_sizeMB(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Torrent_sizeMB(ctx, field)
+func (ec *executionContext) _ReleaseInfo_season(ctx context.Context, field graphql.CollectedField, obj *ReleaseInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReleaseInfo_season(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2072,38 +2454,35 @@ func (ec *executionContext) _Torrent_sizeMB(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SizeMb, nil
+		return obj.Season, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Torrent_sizeMB(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ReleaseInfo_season(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Torrent",
+		Object:     "ReleaseInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Torrent_addedDate(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Torrent_addedDate(ctx, field)
+func (ec *executionContext) _ReleaseInfo_seasonTo(ctx context.Context, field graphql.CollectedField, obj *ReleaseInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReleaseInfo_seasonTo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2116,38 +2495,35 @@ func (ec *executionContext) _Torrent_addedDate(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AddedDate, nil
+		return obj.SeasonTo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Torrent_addedDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ReleaseInfo_seasonTo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Torrent",
+		Object:     "ReleaseInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Torrent_url(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Torrent_url(ctx, field)
+func (ec *executionContext) _ReleaseInfo_episodeFrom(ctx context.Context, field graphql.CollectedField, obj *ReleaseInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReleaseInfo_episodeFrom(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2160,38 +2536,1553 @@ func (ec *executionContext) _Torrent_url(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.URL, nil
+		return obj.EpisodeFrom, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReleaseInfo_episodeFrom(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReleaseInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReleaseInfo_episodeTo(ctx context.Context, field graphql.CollectedField, obj *ReleaseInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReleaseInfo_episodeTo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EpisodeTo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReleaseInfo_episodeTo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReleaseInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReleaseInfo_resolution(ctx context.Context, field graphql.CollectedField, obj *ReleaseInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReleaseInfo_resolution(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Resolution, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReleaseInfo_resolution(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReleaseInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReleaseInfo_source(ctx context.Context, field graphql.CollectedField, obj *ReleaseInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReleaseInfo_source(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Source, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReleaseInfo_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReleaseInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReleaseInfo_codec(ctx context.Context, field graphql.CollectedField, obj *ReleaseInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReleaseInfo_codec(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Codec, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReleaseInfo_codec(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReleaseInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReleaseInfo_hdr(ctx context.Context, field graphql.CollectedField, obj *ReleaseInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReleaseInfo_hdr(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Hdr, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReleaseInfo_hdr(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReleaseInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReleaseInfo_languages(ctx context.Context, field graphql.CollectedField, obj *ReleaseInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReleaseInfo_languages(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Languages, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReleaseInfo_languages(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReleaseInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReleaseInfo_dubbed(ctx context.Context, field graphql.CollectedField, obj *ReleaseInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReleaseInfo_dubbed(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Dubbed, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReleaseInfo_dubbed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReleaseInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReleaseInfo_group(ctx context.Context, field graphql.CollectedField, obj *ReleaseInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReleaseInfo_group(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Group, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReleaseInfo_group(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReleaseInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Torrent_id(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Torrent_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Torrent_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Torrent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Torrent_name(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Torrent_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Torrent_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Torrent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Torrent_category(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Torrent_category(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Category, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Torrent_category(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Torrent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Torrent_sizeMB(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Torrent_sizeMB(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SizeMb, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Torrent_sizeMB(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Torrent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Torrent_addedDate(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Torrent_addedDate(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AddedDate, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Torrent_addedDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Torrent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Torrent_addedDateRaw(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Torrent_addedDateRaw(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AddedDateRaw, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Torrent_addedDateRaw(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Torrent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Torrent_url(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Torrent_url(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_Torrent_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Torrent",
+		Object:     "Torrent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Torrent_imageURL(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Torrent_imageURL(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ImageURL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Torrent_imageURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Torrent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Torrent_csfdRating(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Torrent_csfdRating(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CsfdRating, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Torrent_csfdRating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Torrent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Torrent_csfdURL(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Torrent_csfdURL(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CsfdURL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Torrent_csfdURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Torrent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Torrent_createdAt(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Torrent_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Torrent_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Torrent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Torrent_updatedAt(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Torrent_updatedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Torrent_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Torrent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Torrent_seeds(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Torrent_seeds(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Seeds, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Torrent_seeds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Torrent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Torrent_leeches(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Torrent_leeches(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Leeches, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Torrent_leeches(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Torrent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Torrent_details(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Torrent_details(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Torrent().Details(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*TorrentDetails)
+	fc.Result = res
+	return ec.marshalOTorrentDetails2ᚖgithubᚗcomᚋJaLe29ᚋsearchᚑmeᚑplzᚑsktorrentᚋgraphqlᚐTorrentDetails(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Torrent_details(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Torrent",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext_TorrentDetails_description(ctx, field)
+			case "uploader":
+				return ec.fieldContext_TorrentDetails_uploader(ctx, field)
+			case "fileCount":
+				return ec.fieldContext_TorrentDetails_fileCount(ctx, field)
+			case "completedCount":
+				return ec.fieldContext_TorrentDetails_completedCount(ctx, field)
+			case "imdbURL":
+				return ec.fieldContext_TorrentDetails_imdbURL(ctx, field)
+			case "trailerURL":
+				return ec.fieldContext_TorrentDetails_trailerURL(ctx, field)
+			case "audio":
+				return ec.fieldContext_TorrentDetails_audio(ctx, field)
+			case "subtitles":
+				return ec.fieldContext_TorrentDetails_subtitles(ctx, field)
+			case "infoHash":
+				return ec.fieldContext_TorrentDetails_infoHash(ctx, field)
+			case "fetchedAt":
+				return ec.fieldContext_TorrentDetails_fetchedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TorrentDetails", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Torrent_magnetURI(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Torrent_magnetURI(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Torrent().MagnetURI(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Torrent_magnetURI(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Torrent",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Torrent_release(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Torrent_release(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Release, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*ReleaseInfo)
+	fc.Result = res
+	return ec.marshalNReleaseInfo2ᚖgithubᚗcomᚋJaLe29ᚋsearchᚑmeᚑplzᚑsktorrentᚋgraphqlᚐReleaseInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Torrent_release(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Torrent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "title":
+				return ec.fieldContext_ReleaseInfo_title(ctx, field)
+			case "originalTitle":
+				return ec.fieldContext_ReleaseInfo_originalTitle(ctx, field)
+			case "year":
+				return ec.fieldContext_ReleaseInfo_year(ctx, field)
+			case "season":
+				return ec.fieldContext_ReleaseInfo_season(ctx, field)
+			case "seasonTo":
+				return ec.fieldContext_ReleaseInfo_seasonTo(ctx, field)
+			case "episodeFrom":
+				return ec.fieldContext_ReleaseInfo_episodeFrom(ctx, field)
+			case "episodeTo":
+				return ec.fieldContext_ReleaseInfo_episodeTo(ctx, field)
+			case "resolution":
+				return ec.fieldContext_ReleaseInfo_resolution(ctx, field)
+			case "source":
+				return ec.fieldContext_ReleaseInfo_source(ctx, field)
+			case "codec":
+				return ec.fieldContext_ReleaseInfo_codec(ctx, field)
+			case "hdr":
+				return ec.fieldContext_ReleaseInfo_hdr(ctx, field)
+			case "languages":
+				return ec.fieldContext_ReleaseInfo_languages(ctx, field)
+			case "dubbed":
+				return ec.fieldContext_ReleaseInfo_dubbed(ctx, field)
+			case "group":
+				return ec.fieldContext_ReleaseInfo_group(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ReleaseInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Torrent_changes(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Torrent_changes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Torrent().Changes(rctx, obj, fc.Args["limit"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*TorrentChange)
+	fc.Result = res
+	return ec.marshalNTorrentChange2ᚕᚖgithubᚗcomᚋJaLe29ᚋsearchᚑmeᚑplzᚑsktorrentᚋgraphqlᚐTorrentChangeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Torrent_changes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Torrent",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_TorrentChange_field(ctx, field)
+			case "oldValue":
+				return ec.fieldContext_TorrentChange_oldValue(ctx, field)
+			case "newValue":
+				return ec.fieldContext_TorrentChange_newValue(ctx, field)
+			case "changedAt":
+				return ec.fieldContext_TorrentChange_changedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TorrentChange", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Torrent_changes_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TorrentChange_field(ctx context.Context, field graphql.CollectedField, obj *TorrentChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TorrentChange_field(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Field, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TorrentChange_field(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TorrentChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TorrentChange_oldValue(ctx context.Context, field graphql.CollectedField, obj *TorrentChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TorrentChange_oldValue(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OldValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TorrentChange_oldValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TorrentChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TorrentChange_newValue(ctx context.Context, field graphql.CollectedField, obj *TorrentChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TorrentChange_newValue(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NewValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TorrentChange_newValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TorrentChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TorrentChange_changedAt(ctx context.Context, field graphql.CollectedField, obj *TorrentChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TorrentChange_changedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ChangedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TorrentChange_changedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TorrentChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TorrentConnection_torrents(ctx context.Context, field graphql.CollectedField, obj *TorrentConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TorrentConnection_torrents(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Torrents, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Torrent)
+	fc.Result = res
+	return ec.marshalNTorrent2ᚕᚖgithubᚗcomᚋJaLe29ᚋsearchᚑmeᚑplzᚑsktorrentᚋgraphqlᚐTorrentᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TorrentConnection_torrents(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TorrentConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Torrent_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Torrent_name(ctx, field)
+			case "category":
+				return ec.fieldContext_Torrent_category(ctx, field)
+			case "sizeMB":
+				return ec.fieldContext_Torrent_sizeMB(ctx, field)
+			case "addedDate":
+				return ec.fieldContext_Torrent_addedDate(ctx, field)
+			case "addedDateRaw":
+				return ec.fieldContext_Torrent_addedDateRaw(ctx, field)
+			case "url":
+				return ec.fieldContext_Torrent_url(ctx, field)
+			case "imageURL":
+				return ec.fieldContext_Torrent_imageURL(ctx, field)
+			case "csfdRating":
+				return ec.fieldContext_Torrent_csfdRating(ctx, field)
+			case "csfdURL":
+				return ec.fieldContext_Torrent_csfdURL(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Torrent_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Torrent_updatedAt(ctx, field)
+			case "seeds":
+				return ec.fieldContext_Torrent_seeds(ctx, field)
+			case "leeches":
+				return ec.fieldContext_Torrent_leeches(ctx, field)
+			case "details":
+				return ec.fieldContext_Torrent_details(ctx, field)
+			case "magnetURI":
+				return ec.fieldContext_Torrent_magnetURI(ctx, field)
+			case "release":
+				return ec.fieldContext_Torrent_release(ctx, field)
+			case "changes":
+				return ec.fieldContext_Torrent_changes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Torrent", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TorrentConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *TorrentConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TorrentConnection_totalCount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TorrentConnection_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TorrentConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TorrentConnection_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *TorrentConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TorrentConnection_hasNextPage(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HasNextPage, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TorrentConnection_hasNextPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TorrentConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Torrent_imageURL(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Torrent_imageURL(ctx, field)
+func (ec *executionContext) _TorrentConnection_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *TorrentConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TorrentConnection_hasPreviousPage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2204,35 +4095,38 @@ func (ec *executionContext) _Torrent_imageURL(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ImageURL, nil
+		return obj.HasPreviousPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Torrent_imageURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TorrentConnection_hasPreviousPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Torrent",
+		Object:     "TorrentConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Torrent_csfdRating(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Torrent_csfdRating(ctx, field)
+func (ec *executionContext) _TorrentDetails_description(ctx context.Context, field graphql.CollectedField, obj *TorrentDetails) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TorrentDetails_description(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2245,35 +4139,38 @@ func (ec *executionContext) _Torrent_csfdRating(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CsfdRating, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Torrent_csfdRating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TorrentDetails_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Torrent",
+		Object:     "TorrentDetails",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Torrent_csfdURL(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Torrent_csfdURL(ctx, field)
+func (ec *executionContext) _TorrentDetails_uploader(ctx context.Context, field graphql.CollectedField, obj *TorrentDetails) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TorrentDetails_uploader(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2286,7 +4183,7 @@ func (ec *executionContext) _Torrent_csfdURL(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CsfdURL, nil
+		return obj.Uploader, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2300,9 +4197,9 @@ func (ec *executionContext) _Torrent_csfdURL(ctx context.Context, field graphql.
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Torrent_csfdURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TorrentDetails_uploader(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Torrent",
+		Object:     "TorrentDetails",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2313,8 +4210,8 @@ func (ec *executionContext) fieldContext_Torrent_csfdURL(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _Torrent_createdAt(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Torrent_createdAt(ctx, field)
+func (ec *executionContext) _TorrentDetails_fileCount(ctx context.Context, field graphql.CollectedField, obj *TorrentDetails) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TorrentDetails_fileCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2327,7 +4224,7 @@ func (ec *executionContext) _Torrent_createdAt(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.FileCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2339,26 +4236,26 @@ func (ec *executionContext) _Torrent_createdAt(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Torrent_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TorrentDetails_fileCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Torrent",
+		Object:     "TorrentDetails",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Torrent_updatedAt(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Torrent_updatedAt(ctx, field)
+func (ec *executionContext) _TorrentDetails_completedCount(ctx context.Context, field graphql.CollectedField, obj *TorrentDetails) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TorrentDetails_completedCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2371,7 +4268,7 @@ func (ec *executionContext) _Torrent_updatedAt(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.CompletedCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2383,26 +4280,26 @@ func (ec *executionContext) _Torrent_updatedAt(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Torrent_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TorrentDetails_completedCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Torrent",
+		Object:     "TorrentDetails",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Torrent_seeds(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Torrent_seeds(ctx, field)
+func (ec *executionContext) _TorrentDetails_imdbURL(ctx context.Context, field graphql.CollectedField, obj *TorrentDetails) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TorrentDetails_imdbURL(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2415,38 +4312,35 @@ func (ec *executionContext) _Torrent_seeds(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Seeds, nil
+		return obj.ImdbURL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Torrent_seeds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TorrentDetails_imdbURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Torrent",
+		Object:     "TorrentDetails",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Torrent_leeches(ctx context.Context, field graphql.CollectedField, obj *Torrent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Torrent_leeches(ctx, field)
+func (ec *executionContext) _TorrentDetails_trailerURL(ctx context.Context, field graphql.CollectedField, obj *TorrentDetails) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TorrentDetails_trailerURL(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2459,38 +4353,35 @@ func (ec *executionContext) _Torrent_leeches(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Leeches, nil
+		return obj.TrailerURL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Torrent_leeches(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TorrentDetails_trailerURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Torrent",
+		Object:     "TorrentDetails",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TorrentConnection_torrents(ctx context.Context, field graphql.CollectedField, obj *TorrentConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TorrentConnection_torrents(ctx, field)
+func (ec *executionContext) _TorrentDetails_audio(ctx context.Context, field graphql.CollectedField, obj *TorrentDetails) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TorrentDetails_audio(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2503,66 +4394,35 @@ func (ec *executionContext) _TorrentConnection_torrents(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Torrents, nil
+		return obj.Audio, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*Torrent)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNTorrent2ᚕᚖgithubᚗcomᚋJaLe29ᚋsearchᚑmeᚑplzᚑsktorrentᚋgraphqlᚐTorrentᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TorrentConnection_torrents(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TorrentDetails_audio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TorrentConnection",
+		Object:     "TorrentDetails",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Torrent_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Torrent_name(ctx, field)
-			case "category":
-				return ec.fieldContext_Torrent_category(ctx, field)
-			case "sizeMB":
-				return ec.fieldContext_Torrent_sizeMB(ctx, field)
-			case "addedDate":
-				return ec.fieldContext_Torrent_addedDate(ctx, field)
-			case "url":
-				return ec.fieldContext_Torrent_url(ctx, field)
-			case "imageURL":
-				return ec.fieldContext_Torrent_imageURL(ctx, field)
-			case "csfdRating":
-				return ec.fieldContext_Torrent_csfdRating(ctx, field)
-			case "csfdURL":
-				return ec.fieldContext_Torrent_csfdURL(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Torrent_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Torrent_updatedAt(ctx, field)
-			case "seeds":
-				return ec.fieldContext_Torrent_seeds(ctx, field)
-			case "leeches":
-				return ec.fieldContext_Torrent_leeches(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Torrent", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TorrentConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *TorrentConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TorrentConnection_totalCount(ctx, field)
+func (ec *executionContext) _TorrentDetails_subtitles(ctx context.Context, field graphql.CollectedField, obj *TorrentDetails) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TorrentDetails_subtitles(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2575,38 +4435,35 @@ func (ec *executionContext) _TorrentConnection_totalCount(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.Subtitles, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TorrentConnection_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TorrentDetails_subtitles(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TorrentConnection",
+		Object:     "TorrentDetails",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TorrentConnection_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *TorrentConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TorrentConnection_hasNextPage(ctx, field)
+func (ec *executionContext) _TorrentDetails_infoHash(ctx context.Context, field graphql.CollectedField, obj *TorrentDetails) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TorrentDetails_infoHash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2619,7 +4476,7 @@ func (ec *executionContext) _TorrentConnection_hasNextPage(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HasNextPage, nil
+		return obj.InfoHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2631,26 +4488,26 @@ func (ec *executionContext) _TorrentConnection_hasNextPage(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TorrentConnection_hasNextPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TorrentDetails_infoHash(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TorrentConnection",
+		Object:     "TorrentDetails",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TorrentConnection_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *TorrentConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TorrentConnection_hasPreviousPage(ctx, field)
+func (ec *executionContext) _TorrentDetails_fetchedAt(ctx context.Context, field graphql.CollectedField, obj *TorrentDetails) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TorrentDetails_fetchedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2663,7 +4520,7 @@ func (ec *executionContext) _TorrentConnection_hasPreviousPage(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HasPreviousPage, nil
+		return obj.FetchedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2675,19 +4532,19 @@ func (ec *executionContext) _TorrentConnection_hasPreviousPage(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TorrentConnection_hasPreviousPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TorrentDetails_fetchedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TorrentConnection",
+		Object:     "TorrentDetails",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
@@ -4864,6 +6721,96 @@ func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field
 
 // region    **************************** input.gotpl *****************************
 
+func (ec *executionContext) unmarshalInputReleaseFilter(ctx context.Context, obj any) (ReleaseFilter, error) {
+	var it ReleaseFilter
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"year", "season", "episode", "resolution", "source", "codec", "hdr", "language", "dubbed", "group"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "year":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("year"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Year = data
+		case "season":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("season"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Season = data
+		case "episode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("episode"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Episode = data
+		case "resolution":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("resolution"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Resolution = data
+		case "source":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("source"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Source = data
+		case "codec":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("codec"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Codec = data
+		case "hdr":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hdr"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Hdr = data
+		case "language":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("language"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Language = data
+		case "dubbed":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dubbed"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Dubbed = data
+		case "group":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("group"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Group = data
+		}
+	}
+
+	return it, nil
+}
+
 // endregion **************************** input.gotpl *****************************
 
 // region    ************************** interface.gotpl ***************************
@@ -5188,6 +7135,77 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
+var releaseInfoImplementors = []string{"ReleaseInfo"}
+
+func (ec *executionContext) _ReleaseInfo(ctx context.Context, sel ast.SelectionSet, obj *ReleaseInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, releaseInfoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ReleaseInfo")
+		case "title":
+			out.Values[i] = ec._ReleaseInfo_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "originalTitle":
+			out.Values[i] = ec._ReleaseInfo_originalTitle(ctx, field, obj)
+		case "year":
+			out.Values[i] = ec._ReleaseInfo_year(ctx, field, obj)
+		case "season":
+			out.Values[i] = ec._ReleaseInfo_season(ctx, field, obj)
+		case "seasonTo":
+			out.Values[i] = ec._ReleaseInfo_seasonTo(ctx, field, obj)
+		case "episodeFrom":
+			out.Values[i] = ec._ReleaseInfo_episodeFrom(ctx, field, obj)
+		case "episodeTo":
+			out.Values[i] = ec._ReleaseInfo_episodeTo(ctx, field, obj)
+		case "resolution":
+			out.Values[i] = ec._ReleaseInfo_resolution(ctx, field, obj)
+		case "source":
+			out.Values[i] = ec._ReleaseInfo_source(ctx, field, obj)
+		case "codec":
+			out.Values[i] = ec._ReleaseInfo_codec(ctx, field, obj)
+		case "hdr":
+			out.Values[i] = ec._ReleaseInfo_hdr(ctx, field, obj)
+		case "languages":
+			out.Values[i] = ec._ReleaseInfo_languages(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dubbed":
+			out.Values[i] = ec._ReleaseInfo_dubbed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "group":
+			out.Values[i] = ec._ReleaseInfo_group(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var torrentImplementors = []string{"Torrent"}
 
 func (ec *executionContext) _Torrent(ctx context.Context, sel ast.SelectionSet, obj *Torrent) graphql.Marshaler {
@@ -5202,32 +7220,31 @@ func (ec *executionContext) _Torrent(ctx context.Context, sel ast.SelectionSet,
 		case "id":
 			out.Values[i] = ec._Torrent_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "name":
 			out.Values[i] = ec._Torrent_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "category":
 			out.Values[i] = ec._Torrent_category(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "sizeMB":
 			out.Values[i] = ec._Torrent_sizeMB(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "addedDate":
 			out.Values[i] = ec._Torrent_addedDate(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
+		case "addedDateRaw":
+			out.Values[i] = ec._Torrent_addedDateRaw(ctx, field, obj)
 		case "url":
 			out.Values[i] = ec._Torrent_url(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "imageURL":
 			out.Values[i] = ec._Torrent_imageURL(ctx, field, obj)
@@ -5238,20 +7255,175 @@ func (ec *executionContext) _Torrent(ctx context.Context, sel ast.SelectionSet,
 		case "createdAt":
 			out.Values[i] = ec._Torrent_createdAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "updatedAt":
 			out.Values[i] = ec._Torrent_updatedAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "seeds":
 			out.Values[i] = ec._Torrent_seeds(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "leeches":
 			out.Values[i] = ec._Torrent_leeches(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "details":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Torrent_details(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "magnetURI":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Torrent_magnetURI(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "release":
+			out.Values[i] = ec._Torrent_release(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "changes":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Torrent_changes(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var torrentChangeImplementors = []string{"TorrentChange"}
+
+func (ec *executionContext) _TorrentChange(ctx context.Context, sel ast.SelectionSet, obj *TorrentChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, torrentChangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TorrentChange")
+		case "field":
+			out.Values[i] = ec._TorrentChange_field(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "oldValue":
+			out.Values[i] = ec._TorrentChange_oldValue(ctx, field, obj)
+		case "newValue":
+			out.Values[i] = ec._TorrentChange_newValue(ctx, field, obj)
+		case "changedAt":
+			out.Values[i] = ec._TorrentChange_changedAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -5332,6 +7504,75 @@ func (ec *executionContext) _TorrentConnection(ctx context.Context, sel ast.Sele
 	return out
 }
 
+var torrentDetailsImplementors = []string{"TorrentDetails"}
+
+func (ec *executionContext) _TorrentDetails(ctx context.Context, sel ast.SelectionSet, obj *TorrentDetails) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, torrentDetailsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TorrentDetails")
+		case "description":
+			out.Values[i] = ec._TorrentDetails_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "uploader":
+			out.Values[i] = ec._TorrentDetails_uploader(ctx, field, obj)
+		case "fileCount":
+			out.Values[i] = ec._TorrentDetails_fileCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "completedCount":
+			out.Values[i] = ec._TorrentDetails_completedCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "imdbURL":
+			out.Values[i] = ec._TorrentDetails_imdbURL(ctx, field, obj)
+		case "trailerURL":
+			out.Values[i] = ec._TorrentDetails_trailerURL(ctx, field, obj)
+		case "audio":
+			out.Values[i] = ec._TorrentDetails_audio(ctx, field, obj)
+		case "subtitles":
+			out.Values[i] = ec._TorrentDetails_subtitles(ctx, field, obj)
+		case "infoHash":
+			out.Values[i] = ec._TorrentDetails_infoHash(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fetchedAt":
+			out.Values[i] = ec._TorrentDetails_fetchedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var torrentStatsImplementors = []string{"TorrentStats"}
 
 func (ec *executionContext) _TorrentStats(ctx context.Context, sel ast.SelectionSet, obj *TorrentStats) graphql.Marshaler {
@@ -5858,6 +8099,16 @@ func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.Selecti
 	return res
 }
 
+func (ec *executionContext) marshalNReleaseInfo2ᚖgithubᚗcomᚋJaLe29ᚋsearchᚑmeᚑplzᚑsktorrentᚋgraphqlᚐReleaseInfo(ctx context.Context, sel ast.SelectionSet, v *ReleaseInfo) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ReleaseInfo(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -5874,6 +8125,36 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalNTime2timeᚐTime(ctx context.Context, v any) (time.Time, error) {
 	res, err := graphql.UnmarshalTime(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -5944,6 +8225,60 @@ func (ec *executionContext) marshalNTorrent2ᚖgithubᚗcomᚋJaLe29ᚋsearchᚑ
 	return ec._Torrent(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNTorrentChange2ᚕᚖgithubᚗcomᚋJaLe29ᚋsearchᚑmeᚑplzᚑsktorrentᚋgraphqlᚐTorrentChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*TorrentChange) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTorrentChange2ᚖgithubᚗcomᚋJaLe29ᚋsearchᚑmeᚑplzᚑsktorrentᚋgraphqlᚐTorrentChange(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNTorrentChange2ᚖgithubᚗcomᚋJaLe29ᚋsearchᚑmeᚑplzᚑsktorrentᚋgraphqlᚐTorrentChange(ctx context.Context, sel ast.SelectionSet, v *TorrentChange) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._TorrentChange(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNTorrentConnection2githubᚗcomᚋJaLe29ᚋsearchᚑmeᚑplzᚑsktorrentᚋgraphqlᚐTorrentConnection(ctx context.Context, sel ast.SelectionSet, v TorrentConnection) graphql.Marshaler {
 	return ec._TorrentConnection(ctx, sel, &v)
 }
@@ -6259,6 +8594,14 @@ func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.Sele
 	return res
 }
 
+func (ec *executionContext) unmarshalOReleaseFilter2ᚖgithubᚗcomᚋJaLe29ᚋsearchᚑmeᚑplzᚑsktorrentᚋgraphqlᚐReleaseFilter(ctx context.Context, v any) (*ReleaseFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputReleaseFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v any) (*string, error) {
 	if v == nil {
 		return nil, nil
@@ -6277,6 +8620,24 @@ func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel as
 	return res
 }
 
+func (ec *executionContext) unmarshalOTime2ᚖtimeᚐTime(ctx context.Context, v any) (*time.Time, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalTime(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOTime2ᚖtimeᚐTime(ctx context.Context, sel ast.SelectionSet, v *time.Time) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalTime(*v)
+	return res
+}
+
 func (ec *executionContext) marshalOTorrent2ᚖgithubᚗcomᚋJaLe29ᚋsearchᚑmeᚑplzᚑsktorrentᚋgraphqlᚐTorrent(ctx context.Context, sel ast.SelectionSet, v *Torrent) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -6284,6 +8645,13 @@ func (ec *executionContext) marshalOTorrent2ᚖgithubᚗcomᚋJaLe29ᚋsearchᚑ
 	return ec._Torrent(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOTorrentDetails2ᚖgithubᚗcomᚋJaLe29ᚋsearchᚑmeᚑplzᚑsktorrentᚋgraphqlᚐTorrentDetails(ctx context.Context, sel ast.SelectionSet, v *TorrentDetails) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._TorrentDetails(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOTorrentSortBy2ᚖgithubᚗcomᚋJaLe29ᚋsearchᚑmeᚑplzᚑsktorrentᚋgraphqlᚐTorrentSortBy(ctx context.Context, v any) (*TorrentSortBy, error) {
 	if v == nil {
 		return nil, nil
@@ -0,0 +1,80 @@
+package graphql
+
+// Převody z databázových typů na GraphQL modely. Tento soubor gqlgen
+// negeneruje, takže se při regeneraci resolverů nepřesouvá.
+
+import (
+	"time"
+
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/database"
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/release"
+)
+
+// mapTorrentWithStatsToGraphQL převede torrent s aktuálními statistikami na GraphQL Torrent
+func mapTorrentWithStatsToGraphQL(t database.TorrentWithStats) *Torrent {
+	return &Torrent{
+		ID:           t.ID,
+		Name:         t.Name,
+		Category:     t.Category,
+		SizeMb:       t.SizeMB,
+		AddedDate:    optionalTime(t.AddedDate),
+		AddedDateRaw: optionalString(t.AddedRaw),
+		URL:          t.URL,
+		ImageURL:     &t.ImageURL,
+		CsfdRating:   &t.CSFDRating,
+		CsfdURL:      &t.CSFDURL,
+		CreatedAt:    t.CreatedAt,
+		UpdatedAt:    t.UpdatedAt,
+		Seeds:        t.Seeds,
+		Leeches:      t.Leeches,
+		Release:      mapReleaseToGraphQL(t.Release),
+	}
+}
+
+// mapReleaseToGraphQL převede rozpoznané údaje z názvu na GraphQL ReleaseInfo
+func mapReleaseToGraphQL(r release.Info) *ReleaseInfo {
+	languages := r.Languages
+	if languages == nil {
+		languages = []string{}
+	}
+	return &ReleaseInfo{
+		Title:         r.Title,
+		OriginalTitle: optionalString(r.OriginalTitle),
+		Year:          optionalInt(r.Year),
+		Season:        optionalInt(r.Season),
+		SeasonTo:      optionalInt(r.SeasonTo),
+		EpisodeFrom:   optionalInt(r.EpisodeFrom),
+		EpisodeTo:     optionalInt(r.EpisodeTo),
+		Resolution:    optionalString(r.Resolution),
+		Source:        optionalString(r.Source),
+		Codec:         optionalString(r.Codec),
+		Hdr:           optionalString(r.HDR),
+		Languages:     languages,
+		Dubbed:        r.Dubbed,
+		Group:         optionalString(r.Group),
+	}
+}
+
+// optionalString vrátí nil pro prázdný řetězec (nullable pole v GraphQL)
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// optionalTime vrátí nil pro nulový čas (datum, které se nepodařilo přečíst)
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// optionalInt vrátí nil pro nulu (neuvedený rok, série, díl)
+func optionalInt(n int) *int {
+	if n == 0 {
+		return nil
+	}
+	return &n
+}
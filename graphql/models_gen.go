@@ -24,20 +24,62 @@ type DatabaseStats struct {
 type Query struct {
 }
 
+type ReleaseFilter struct {
+	Year       *int    `json:"year,omitempty"`
+	Season     *int    `json:"season,omitempty"`
+	Episode    *int    `json:"episode,omitempty"`
+	Resolution *string `json:"resolution,omitempty"`
+	Source     *string `json:"source,omitempty"`
+	Codec      *string `json:"codec,omitempty"`
+	Hdr        *bool   `json:"hdr,omitempty"`
+	Language   *string `json:"language,omitempty"`
+	Dubbed     *bool   `json:"dubbed,omitempty"`
+	Group      *string `json:"group,omitempty"`
+}
+
+type ReleaseInfo struct {
+	Title         string   `json:"title"`
+	OriginalTitle *string  `json:"originalTitle,omitempty"`
+	Year          *int     `json:"year,omitempty"`
+	Season        *int     `json:"season,omitempty"`
+	SeasonTo      *int     `json:"seasonTo,omitempty"`
+	EpisodeFrom   *int     `json:"episodeFrom,omitempty"`
+	EpisodeTo     *int     `json:"episodeTo,omitempty"`
+	Resolution    *string  `json:"resolution,omitempty"`
+	Source        *string  `json:"source,omitempty"`
+	Codec         *string  `json:"codec,omitempty"`
+	Hdr           *string  `json:"hdr,omitempty"`
+	Languages     []string `json:"languages"`
+	Dubbed        bool     `json:"dubbed"`
+	Group         *string  `json:"group,omitempty"`
+}
+
 type Torrent struct {
-	ID         string    `json:"id"`
-	Name       string    `json:"name"`
-	Category   string    `json:"category"`
-	SizeMb     float64   `json:"sizeMB"`
-	AddedDate  time.Time `json:"addedDate"`
-	URL        string    `json:"url"`
-	ImageURL   *string   `json:"imageURL,omitempty"`
-	CsfdRating *int      `json:"csfdRating,omitempty"`
-	CsfdURL    *string   `json:"csfdURL,omitempty"`
-	CreatedAt  time.Time `json:"createdAt"`
-	UpdatedAt  time.Time `json:"updatedAt"`
-	Seeds      int       `json:"seeds"`
-	Leeches    int       `json:"leeches"`
+	ID           string           `json:"id"`
+	Name         string           `json:"name"`
+	Category     string           `json:"category"`
+	SizeMb       float64          `json:"sizeMB"`
+	AddedDate    *time.Time       `json:"addedDate,omitempty"`
+	AddedDateRaw *string          `json:"addedDateRaw,omitempty"`
+	URL          string           `json:"url"`
+	ImageURL     *string          `json:"imageURL,omitempty"`
+	CsfdRating   *int             `json:"csfdRating,omitempty"`
+	CsfdURL      *string          `json:"csfdURL,omitempty"`
+	CreatedAt    time.Time        `json:"createdAt"`
+	UpdatedAt    time.Time        `json:"updatedAt"`
+	Seeds        int              `json:"seeds"`
+	Leeches      int              `json:"leeches"`
+	Details      *TorrentDetails  `json:"details,omitempty"`
+	MagnetURI    *string          `json:"magnetURI,omitempty"`
+	Release      *ReleaseInfo     `json:"release"`
+	Changes      []*TorrentChange `json:"changes"`
+}
+
+type TorrentChange struct {
+	Field     string    `json:"field"`
+	OldValue  *string   `json:"oldValue,omitempty"`
+	NewValue  *string   `json:"newValue,omitempty"`
+	ChangedAt time.Time `json:"changedAt"`
 }
 
 type TorrentConnection struct {
@@ -47,6 +89,19 @@ type TorrentConnection struct {
 	HasPreviousPage bool       `json:"hasPreviousPage"`
 }
 
+type TorrentDetails struct {
+	Description    string    `json:"description"`
+	Uploader       *string   `json:"uploader,omitempty"`
+	FileCount      int       `json:"fileCount"`
+	CompletedCount int       `json:"completedCount"`
+	ImdbURL        *string   `json:"imdbURL,omitempty"`
+	TrailerURL     *string   `json:"trailerURL,omitempty"`
+	Audio          *string   `json:"audio,omitempty"`
+	Subtitles      *string   `json:"subtitles,omitempty"`
+	InfoHash       string    `json:"infoHash"`
+	FetchedAt      time.Time `json:"fetchedAt"`
+}
+
 type TorrentStats struct {
 	ID         string    `json:"id"`
 	TorrentID  string    `json:"torrentID"`
@@ -7,5 +7,6 @@ package graphql
 import "github.com/JaLe29/search-me-plz-sktorrent/internal/database"
 
 type Resolver struct {
-	DB *database.Database
+	DB       *database.Database
+	Trackers []string // trackery pro magnet odkazy (prázdné = magnet.DefaultTrackers)
 }
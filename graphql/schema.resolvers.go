@@ -9,26 +9,9 @@ import (
 	"strconv"
 
 	"github.com/JaLe29/search-me-plz-sktorrent/internal/database"
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/magnet"
 )
 
-func mapTorrentWithStatsToGraphQL(t database.TorrentWithStats) *Torrent {
-	return &Torrent{
-		ID:         t.ID,
-		Name:       t.Name,
-		Category:   t.Category,
-		SizeMb:     t.SizeMB,
-		AddedDate:  t.AddedDate,
-		URL:        t.URL,
-		ImageURL:   &t.ImageURL,
-		CsfdRating: &t.CSFDRating,
-		CsfdURL:    &t.CSFDURL,
-		CreatedAt:  t.CreatedAt,
-		UpdatedAt:  t.UpdatedAt,
-		Seeds:      t.Seeds,
-		Leeches:    t.Leeches,
-	}
-}
-
 // Torrent is the resolver for the torrent field.
 func (r *queryResolver) Torrent(ctx context.Context, id string) (*Torrent, error) {
 	t, err := r.DB.GetTorrentWithCurrentStats(id)
@@ -39,7 +22,7 @@ func (r *queryResolver) Torrent(ctx context.Context, id string) (*Torrent, error
 }
 
 // Torrents is the resolver for the torrents field.
-func (r *queryResolver) Torrents(ctx context.Context, first *int, after *string, category *string, search *string, sortBy *TorrentSortBy) (*TorrentConnection, error) {
+func (r *queryResolver) Torrents(ctx context.Context, first *int, after *string, category *string, search *string, sortBy *TorrentSortBy, release *ReleaseFilter) (*TorrentConnection, error) {
 	limit := 20
 	if first != nil {
 		limit = *first
@@ -59,8 +42,24 @@ func (r *queryResolver) Torrents(ctx context.Context, first *int, after *string,
 		sortByStr = string(*sortBy)
 	}
 
+	var filter database.ReleaseFilter
+	if release != nil {
+		filter = database.ReleaseFilter{
+			Year:       release.Year,
+			Season:     release.Season,
+			Episode:    release.Episode,
+			Resolution: release.Resolution,
+			Source:     release.Source,
+			Codec:      release.Codec,
+			HDR:        release.Hdr,
+			Language:   release.Language,
+			Dubbed:     release.Dubbed,
+			Group:      release.Group,
+		}
+	}
+
 	// Get torrents with pagination
-	torrents, totalCount, hasNextPage, err := r.DB.GetTorrentsWithPagination(offset, limit, category, search, sortByStr)
+	torrents, totalCount, hasNextPage, err := r.DB.GetTorrentsWithPagination(offset, limit, category, search, sortByStr, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -187,10 +186,72 @@ func (r *queryResolver) Stats(ctx context.Context) (*DatabaseStats, error) {
 	}, nil
 }
 
+// Details is the resolver for the details field.
+func (r *torrentResolver) Details(ctx context.Context, obj *Torrent) (*TorrentDetails, error) {
+	d, err := r.DB.GetTorrentDetails(ctx, obj.ID)
+	if err != nil || d == nil {
+		return nil, err
+	}
+	return &TorrentDetails{
+		Description:    d.Description,
+		Uploader:       optionalString(d.Uploader),
+		FileCount:      d.FileCount,
+		CompletedCount: d.CompletedCount,
+		ImdbURL:        optionalString(d.IMDbURL),
+		TrailerURL:     optionalString(d.TrailerURL),
+		Audio:          optionalString(d.Audio),
+		Subtitles:      optionalString(d.Subtitles),
+		InfoHash:       d.InfoHash,
+		FetchedAt:      d.FetchedAt,
+	}, nil
+}
+
+// MagnetURI is the resolver for the magnetURI field.
+func (r *torrentResolver) MagnetURI(ctx context.Context, obj *Torrent) (*string, error) {
+	if !magnet.IsInfoHash(obj.ID) {
+		return nil, nil
+	}
+	trackers := r.Trackers
+	if len(trackers) == 0 {
+		trackers = magnet.DefaultTrackers
+	}
+	uri, err := magnet.URI(obj.ID, obj.Name, trackers)
+	if err != nil {
+		return nil, err
+	}
+	return &uri, nil
+}
+
+// Changes is the resolver for the changes field.
+func (r *torrentResolver) Changes(ctx context.Context, obj *Torrent, limit *int) ([]*TorrentChange, error) {
+	l := 50
+	if limit != nil {
+		l = *limit
+	}
+	changes, err := r.DB.GetTorrentChanges(ctx, obj.ID, l)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*TorrentChange, 0, len(changes))
+	for _, c := range changes {
+		result = append(result, &TorrentChange{
+			Field:     c.Field,
+			OldValue:  optionalString(c.OldValue),
+			NewValue:  optionalString(c.NewValue),
+			ChangedAt: c.ChangedAt,
+		})
+	}
+	return result, nil
+}
+
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Torrent returns TorrentResolver implementation.
+func (r *Resolver) Torrent() TorrentResolver { return &torrentResolver{r} }
+
 type queryResolver struct{ *Resolver }
+type torrentResolver struct{ *Resolver }
 
 // !!! WARNING !!!
 // The code below was going to be deleted when updating resolvers. It has been copied here so you have
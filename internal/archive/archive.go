@@ -0,0 +1,159 @@
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// Druhy archivovaných stránek (pages.kind)
+const (
+	KindListing = "listing" // torrents_v2.php
+	KindDetail  = "detail"  // details.php
+)
+
+// KindOf určí druh stránky podle URL; "" = stránka se nearchivuje
+// (robots.txt apod.)
+func KindOf(rawURL string) string {
+	switch {
+	case strings.Contains(rawURL, "details.php"):
+		return KindDetail
+	case strings.Contains(rawURL, "torrents_v2.php"):
+		return KindListing
+	}
+	return ""
+}
+
+// Archive uchovává surové HTML stažených stránek (gzip) v samostatné SQLite
+// databázi, aby šlo po opravě parseru přepočítat uložená data bez sítě
+// (cmd/reparse). Každá URL má tolik verzí, kolikrát se její obsah změnil.
+type Archive struct {
+	db *sql.DB
+}
+
+// Page je jedna archivovaná verze stránky
+type Page struct {
+	ID        int64
+	URL       string
+	Kind      string
+	FetchedAt time.Time
+	Body      []byte // rozbalené HTML
+}
+
+// Open otevře archiv, případně ho založí
+func Open(path string) (*Archive, error) {
+	db, err := sql.Open("sqlite", path+"?_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, fmt.Errorf("opening archive: %w", err)
+	}
+
+	schema := []string{
+		`CREATE TABLE IF NOT EXISTS pages (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			url TEXT NOT NULL,
+			kind TEXT NOT NULL,
+			fetched_at DATETIME NOT NULL,
+			body_hash TEXT NOT NULL,
+			size INTEGER NOT NULL,
+			body BLOB NOT NULL
+		);`,
+		`CREATE INDEX IF NOT EXISTS idx_pages_url_fetched ON pages(url, fetched_at);`,
+		`CREATE INDEX IF NOT EXISTS idx_pages_kind_fetched ON pages(kind, fetched_at);`,
+	}
+	for _, s := range schema {
+		if _, err := db.Exec(s); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("creating archive tables: %w", err)
+		}
+	}
+	return &Archive{db: db}, nil
+}
+
+func (a *Archive) Close() error {
+	return a.db.Close()
+}
+
+// Store uloží stránku stáhnutou v čase fetchedAt. Když je tělo shodné
+// s poslední uloženou verzí stejné URL, neuloží nic a vrátí false.
+func (a *Archive) Store(ctx context.Context, rawURL string, fetchedAt time.Time, body []byte) (bool, error) {
+	sum := sha256.Sum256(body)
+	hash := hex.EncodeToString(sum[:])
+
+	var last string
+	err := a.db.QueryRowContext(ctx,
+		"SELECT body_hash FROM pages WHERE url = ? ORDER BY fetched_at DESC LIMIT 1", rawURL).Scan(&last)
+	if err != nil && err != sql.ErrNoRows {
+		return false, fmt.Errorf("getting archived page: %w", err)
+	}
+	if last == hash {
+		return false, nil
+	}
+
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	if _, err := zw.Write(body); err != nil {
+		return false, fmt.Errorf("compressing page: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return false, fmt.Errorf("compressing page: %w", err)
+	}
+
+	_, err = a.db.ExecContext(ctx,
+		"INSERT INTO pages (url, kind, fetched_at, body_hash, size, body) VALUES (?, ?, ?, ?, ?, ?)",
+		rawURL, KindOf(rawURL), fetchedAt.UTC(), hash, len(body), compressed.Bytes())
+	if err != nil {
+		return false, fmt.Errorf("archiving %s: %w", rawURL, err)
+	}
+	return true, nil
+}
+
+// Each předá fn všechny verze stránek druhu kind ("" = všech) od nejstarší
+// po nejnovější. Chyba z fn procházení ukončí a Each ji vrátí.
+func (a *Archive) Each(ctx context.Context, kind string, fn func(Page) error) error {
+	query := "SELECT id, url, kind, fetched_at, body FROM pages"
+	var args []interface{}
+	if kind != "" {
+		query += " WHERE kind = ?"
+		args = append(args, kind)
+	}
+	query += " ORDER BY fetched_at, id"
+
+	rows, err := a.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("reading archive: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var p Page
+		var compressed []byte
+		if err := rows.Scan(&p.ID, &p.URL, &p.Kind, &p.FetchedAt, &compressed); err != nil {
+			return fmt.Errorf("scanning archived page: %w", err)
+		}
+		if p.Body, err = decompress(compressed); err != nil {
+			return fmt.Errorf("archived page %d (%s): %w", p.ID, p.URL, err)
+		}
+		if err := fn(p); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func decompress(data []byte) ([]byte, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	return io.ReadAll(zr)
+}
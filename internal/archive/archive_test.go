@@ -0,0 +1,140 @@
+package archive
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func openTestArchive(t *testing.T) *Archive {
+	t.Helper()
+	a, err := Open(filepath.Join(t.TempDir(), "archive.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { a.Close() })
+	return a
+}
+
+func TestKindOf(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://sktorrent.eu/torrent/torrents_v2.php?page=3", KindListing},
+		{"https://sktorrent.eu/torrent/details.php?name=x&id=abc", KindDetail},
+		{"https://sktorrent.eu/robots.txt", ""},
+	}
+	for _, tt := range tests {
+		if got := KindOf(tt.url); got != tt.want {
+			t.Errorf("KindOf(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestStoreEach(t *testing.T) {
+	ctx := context.Background()
+	a := openTestArchive(t)
+
+	const (
+		listingURL = "https://sktorrent.eu/torrent/torrents_v2.php?page=0"
+		detailURL  = "https://sktorrent.eu/torrent/details.php?name=x&id=abc"
+	)
+	t0 := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	stores := []struct {
+		url  string
+		at   time.Time
+		body string
+		want bool
+	}{
+		{listingURL, t0, "listing A", true},
+		{detailURL, t0.Add(time.Minute), "detail", true},
+		// Stejné tělo jako poslední verze se neukládá
+		{listingURL, t0.Add(time.Hour), "listing A", false},
+		{listingURL, t0.Add(2 * time.Hour), "listing B", true},
+		// Návrat k dřívější verzi je změna oproti poslední
+		{listingURL, t0.Add(3 * time.Hour), "listing A", true},
+		{"https://sktorrent.eu/robots.txt", t0, "User-agent: *", true},
+	}
+	for i, s := range stores {
+		stored, err := a.Store(ctx, s.url, s.at, []byte(s.body))
+		if err != nil {
+			t.Fatalf("Store #%d: %v", i, err)
+		}
+		if stored != s.want {
+			t.Errorf("Store #%d (%s) = %v, want %v", i, s.body, stored, s.want)
+		}
+	}
+
+	var listings []Page
+	err := a.Each(ctx, KindListing, func(p Page) error {
+		listings = append(listings, p)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantBodies := []string{"listing A", "listing B", "listing A"}
+	if len(listings) != len(wantBodies) {
+		t.Fatalf("Each(listing) = %d pages, want %d", len(listings), len(wantBodies))
+	}
+	for i, p := range listings {
+		if string(p.Body) != wantBodies[i] {
+			t.Errorf("page %d body = %q, want %q", i, p.Body, wantBodies[i])
+		}
+		if p.URL != listingURL || p.Kind != KindListing {
+			t.Errorf("page %d = %s (%s), want %s (%s)", i, p.URL, p.Kind, listingURL, KindListing)
+		}
+		if i > 0 && !p.FetchedAt.After(listings[i-1].FetchedAt) {
+			t.Errorf("page %d fetched %v, not after %v", i, p.FetchedAt, listings[i-1].FetchedAt)
+		}
+	}
+	if !listings[0].FetchedAt.Equal(t0) {
+		t.Errorf("FetchedAt = %v, want %v", listings[0].FetchedAt, t0)
+	}
+
+	var all []string
+	err = a.Each(ctx, "", func(p Page) error {
+		all = append(all, p.Kind)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantKinds := []string{KindListing, "", KindDetail, KindListing, KindListing}
+	if len(all) != len(wantKinds) {
+		t.Fatalf("Each(all) kinds = %q, want %q", all, wantKinds)
+	}
+	for i := range all {
+		if all[i] != wantKinds[i] {
+			t.Fatalf("Each(all) kinds = %q, want %q", all, wantKinds)
+		}
+	}
+}
+
+func TestEachStopsOnError(t *testing.T) {
+	ctx := context.Background()
+	a := openTestArchive(t)
+	t0 := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	for i, body := range []string{"a", "b", "c"} {
+		url := "https://sktorrent.eu/torrent/torrents_v2.php?page=" + body
+		if _, err := a.Store(ctx, url, t0.Add(time.Duration(i)*time.Minute), []byte(body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stop := context.Canceled
+	calls := 0
+	err := a.Each(ctx, KindListing, func(Page) error {
+		calls++
+		return stop
+	})
+	if err != stop {
+		t.Errorf("Each error = %v, want %v", err, stop)
+	}
+	if calls != 1 {
+		t.Errorf("fn called %d times, want 1", calls)
+	}
+}
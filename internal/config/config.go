@@ -0,0 +1,277 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config je společné nastavení crawleru, vyhledávání a GraphQL serveru.
+//
+// Pořadí (pozdější přepisuje dřívější): výchozí hodnoty (Default), YAML
+// soubor z -config, proměnné prostředí (SKTORRENT_*), parametry příkazové
+// řádky. Parametry si každá aplikace registruje sama s výchozí hodnotou
+// z Load, takže zadaný parametr vždy vyhraje.
+type Config struct {
+	Database DatabaseConfig `yaml:"database"`
+	Crawler  CrawlerConfig  `yaml:"crawler"`
+	Server   ServerConfig   `yaml:"server"`
+	Log      LogConfig      `yaml:"log"`
+	Trackers []string       `yaml:"trackers"` // trackery pro magnet odkazy (prázdné = veřejné trackery)
+}
+
+type DatabaseConfig struct {
+	Path string `yaml:"path"`
+}
+
+type CrawlerConfig struct {
+	Workers       int           `yaml:"workers"`        // max. souběžných stránek
+	FixedWorkers  bool          `yaml:"fixed_workers"`  // vždy Workers, bez přizpůsobování odezvě
+	TargetLatency time.Duration `yaml:"target_latency"` // pomalejší odpovědi nepřidávají workery
+	Timeout       time.Duration `yaml:"timeout"`        // timeout HTTP požadavku ("30s")
+	Rate          float64       `yaml:"rate"`           // max. požadavků za sekundu (0 = bez limitu)
+	Burst         int           `yaml:"burst"`          // kolik požadavků smí odejít najednou
+	Robots        bool          `yaml:"robots"`         // řídit se robots.txt včetně Crawl-delay
+	Retries       int           `yaml:"retries"`        // max. počet pokusů o stažení stránky
+	MaxErrors     int           `yaml:"max_errors"`     // po kolika po sobě jdoucích HTTP chybách crawling skončí
+	AbortOn       []string      `yaml:"abort_on"`       // třídy chyb, které crawling okamžitě ukončí (http4xx, parse, ...)
+	SkipOn        []string      `yaml:"skip_on"`        // třídy chyb, které se jen přeskočí
+	Details       bool          `yaml:"details"`        // stáhnout detail stránku pro každý torrent
+	Incremental   bool          `yaml:"incremental"`    // procházet, dokud nenarazí na známé torrenty
+	BaseURL       string        `yaml:"base_url"`       // adresa výpisu bez parametrů (torrents_v2.php)
+	Archive       string        `yaml:"archive"`        // SQLite archiv stažených stránek pro cmd/reparse (prázdné = bez archivu)
+}
+
+type ServerConfig struct {
+	Listen      string   `yaml:"listen"`       // adresa serveru, např. ":8080"
+	CORSOrigins []string `yaml:"cors_origins"` // povolené originy ("*" = všechny)
+}
+
+type LogConfig struct {
+	Progress bool   `yaml:"progress"` // crawler: pruh postupu místo výpisu torrentů
+	Events   string `yaml:"events"`   // crawler: soubor pro události jako JSON lines
+	Requests bool   `yaml:"requests"` // server: vypisovat každý požadavek
+}
+
+// DefaultBaseURL je výpis torrentů na sktorrent.eu
+const DefaultBaseURL = "https://sktorrent.eu/torrent/torrents_v2.php"
+
+// Default vrátí nastavení, které platí bez souboru a proměnných prostředí
+func Default() Config {
+	return Config{
+		Database: DatabaseConfig{Path: "torrents.db"},
+		Crawler: CrawlerConfig{
+			Workers:       3,
+			TargetLatency: 2 * time.Second,
+			Timeout:       30 * time.Second,
+			Burst:         1,
+			Retries:       3,
+			MaxErrors:     5,
+			BaseURL:       DefaultBaseURL,
+		},
+		Server: ServerConfig{
+			Listen:      ":8080",
+			CORSOrigins: []string{"*"},
+		},
+		Log: LogConfig{Requests: true},
+	}
+}
+
+// Load načte výchozí hodnoty, soubor path (prázdný = žádný) a proměnné
+// prostředí. Parametry příkazové řádky se aplikují až potom; ověření
+// dělá Validate.
+func Load(path string) (Config, error) {
+	cfg := Default()
+	if path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return cfg, fmt.Errorf("reading config: %w", err)
+		}
+		defer f.Close()
+		// Překlep v názvu klíče je chyba, ne tiše ignorované nastavení
+		decoder := yaml.NewDecoder(f)
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+			return cfg, fmt.Errorf("parsing config %s: %w", path, err)
+		}
+	}
+	if err := cfg.applyEnv(os.LookupEnv); err != nil {
+		return cfg, err
+	}
+	return cfg, nil
+}
+
+// applyEnv přepíše nastavení proměnnými prostředí. PORT a TRACKERS platí
+// kvůli zpětné kompatibilitě; SKTORRENT_* mají přednost.
+func (c *Config) applyEnv(lookup func(string) (string, bool)) error {
+	if port, ok := lookup("PORT"); ok && port != "" {
+		c.Server.Listen = ":" + port
+	}
+	if trackers, ok := lookup("TRACKERS"); ok && trackers != "" {
+		c.Trackers = splitList(trackers)
+	}
+
+	var errs []error
+	str := func(name string, target *string) {
+		if v, ok := lookup(name); ok {
+			*target = v
+		}
+	}
+	list := func(name string, target *[]string) {
+		if v, ok := lookup(name); ok {
+			*target = splitList(v)
+		}
+	}
+	num := func(name string, target *int) {
+		if v, ok := lookup(name); ok {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+				return
+			}
+			*target = n
+		}
+	}
+	float := func(name string, target *float64) {
+		if v, ok := lookup(name); ok {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+				return
+			}
+			*target = f
+		}
+	}
+	duration := func(name string, target *time.Duration) {
+		if v, ok := lookup(name); ok {
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+				return
+			}
+			*target = d
+		}
+	}
+	boolean := func(name string, target *bool) {
+		if v, ok := lookup(name); ok {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+				return
+			}
+			*target = b
+		}
+	}
+
+	str("SKTORRENT_DB_PATH", &c.Database.Path)
+	num("SKTORRENT_CRAWLER_WORKERS", &c.Crawler.Workers)
+	boolean("SKTORRENT_CRAWLER_FIXED_WORKERS", &c.Crawler.FixedWorkers)
+	duration("SKTORRENT_CRAWLER_TARGET_LATENCY", &c.Crawler.TargetLatency)
+	duration("SKTORRENT_CRAWLER_TIMEOUT", &c.Crawler.Timeout)
+	float("SKTORRENT_CRAWLER_RATE", &c.Crawler.Rate)
+	num("SKTORRENT_CRAWLER_BURST", &c.Crawler.Burst)
+	boolean("SKTORRENT_CRAWLER_ROBOTS", &c.Crawler.Robots)
+	num("SKTORRENT_CRAWLER_RETRIES", &c.Crawler.Retries)
+	num("SKTORRENT_CRAWLER_MAX_ERRORS", &c.Crawler.MaxErrors)
+	list("SKTORRENT_CRAWLER_ABORT_ON", &c.Crawler.AbortOn)
+	list("SKTORRENT_CRAWLER_SKIP_ON", &c.Crawler.SkipOn)
+	boolean("SKTORRENT_CRAWLER_DETAILS", &c.Crawler.Details)
+	boolean("SKTORRENT_CRAWLER_INCREMENTAL", &c.Crawler.Incremental)
+	str("SKTORRENT_CRAWLER_BASE_URL", &c.Crawler.BaseURL)
+	str("SKTORRENT_CRAWLER_ARCHIVE", &c.Crawler.Archive)
+	str("SKTORRENT_SERVER_LISTEN", &c.Server.Listen)
+	list("SKTORRENT_SERVER_CORS_ORIGINS", &c.Server.CORSOrigins)
+	boolean("SKTORRENT_LOG_PROGRESS", &c.Log.Progress)
+	str("SKTORRENT_LOG_EVENTS", &c.Log.Events)
+	boolean("SKTORRENT_LOG_REQUESTS", &c.Log.Requests)
+	list("SKTORRENT_TRACKERS", &c.Trackers)
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid environment: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// Validate ověří nastavení po aplikaci parametrů; vrátí všechny problémy najednou
+func (c Config) Validate() error {
+	var errs []error
+	if c.Database.Path == "" {
+		errs = append(errs, errors.New("database.path is empty"))
+	}
+	if c.Crawler.Workers < 1 || c.Crawler.Workers > 20 {
+		errs = append(errs, fmt.Errorf("crawler.workers must be between 1 and 20, got %d", c.Crawler.Workers))
+	}
+	if c.Crawler.TargetLatency <= 0 {
+		errs = append(errs, fmt.Errorf("crawler.target_latency must be positive, got %v", c.Crawler.TargetLatency))
+	}
+	if c.Crawler.Timeout <= 0 {
+		errs = append(errs, fmt.Errorf("crawler.timeout must be positive, got %v", c.Crawler.Timeout))
+	}
+	if c.Crawler.Rate < 0 {
+		errs = append(errs, fmt.Errorf("crawler.rate must not be negative, got %v", c.Crawler.Rate))
+	}
+	if c.Crawler.Burst < 1 {
+		errs = append(errs, fmt.Errorf("crawler.burst must be at least 1, got %d", c.Crawler.Burst))
+	}
+	if c.Crawler.Retries < 1 {
+		errs = append(errs, fmt.Errorf("crawler.retries must be at least 1, got %d", c.Crawler.Retries))
+	}
+	if c.Crawler.MaxErrors < 1 {
+		errs = append(errs, fmt.Errorf("crawler.max_errors must be at least 1, got %d", c.Crawler.MaxErrors))
+	}
+	if u, err := url.Parse(c.Crawler.BaseURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs = append(errs, fmt.Errorf("crawler.base_url must be an absolute http(s) URL, got %q", c.Crawler.BaseURL))
+	}
+	if c.Server.Listen == "" {
+		errs = append(errs, errors.New("server.listen is empty"))
+	}
+	for _, origin := range c.Server.CORSOrigins {
+		if origin == "*" {
+			continue
+		}
+		if u, err := url.Parse(origin); err != nil || u.Scheme == "" || u.Host == "" || u.Path != "" {
+			errs = append(errs, fmt.Errorf("server.cors_origins: %q is not an origin like https://example.com", origin))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// PathFromArgs najde hodnotu -config v argumentech ještě před flag.Parse,
+// aby se z načteného souboru daly vzít výchozí hodnoty parametrů. Bez
+// -config vrátí SKTORRENT_CONFIG.
+func PathFromArgs(args []string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		name := strings.TrimLeft(arg, "-")
+		if name == arg {
+			continue
+		}
+		if value, ok := strings.CutPrefix(name, "config="); ok {
+			return value
+		}
+		if name == "config" && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return os.Getenv("SKTORRENT_CONFIG")
+}
+
+// splitList rozdělí seznam oddělený čárkami a vynechá prázdné položky
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,286 @@
+package config
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeConfig zapíše YAML do dočasného souboru a vrátí jeho cestu
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestDefaultIsValid(t *testing.T) {
+	cfg := Default()
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Default().Validate() = %v", err)
+	}
+	if cfg.Crawler.Rate != 0 {
+		t.Errorf("default rate = %v, want 0 (no limit)", cfg.Crawler.Rate)
+	}
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	path := writeConfig(t, `
+database:
+  path: file.db
+crawler:
+  workers: 5
+  timeout: 10s
+  rate: 2
+  retries: 4
+  abort_on: [http4xx]
+  details: true
+server:
+  listen: ":9000"
+`)
+	t.Setenv("SKTORRENT_CRAWLER_WORKERS", "7")
+	t.Setenv("SKTORRENT_CRAWLER_RETRIES", "6")
+	t.Setenv("SKTORRENT_CRAWLER_SKIP_ON", "http5xx, ratelimit")
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Parametry si aplikace registruje s výchozí hodnotou z Load
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	workers := flags.Int("workers", cfg.Crawler.Workers, "")
+	retries := flags.Int("retries", cfg.Crawler.Retries, "")
+	rate := flags.Float64("rate", cfg.Crawler.Rate, "")
+	if err := flags.Parse([]string{"-retries=8"}); err != nil {
+		t.Fatal(err)
+	}
+	cfg.Crawler.Workers = *workers
+	cfg.Crawler.Retries = *retries
+	cfg.Crawler.Rate = *rate
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"default (nic nepřepisuje)", cfg.Crawler.MaxErrors, 5},
+		{"default (nic nepřepisuje)", cfg.Crawler.BaseURL, DefaultBaseURL},
+		{"soubor přepíše default", cfg.Database.Path, "file.db"},
+		{"soubor přepíše default", cfg.Crawler.Timeout, 10 * time.Second},
+		{"soubor přepíše default", cfg.Crawler.Details, true},
+		{"soubor přepíše default", cfg.Crawler.AbortOn, []string{"http4xx"}},
+		{"soubor, parametr nezadán", cfg.Crawler.Rate, 2.0},
+		{"prostředí přepíše soubor", cfg.Crawler.Workers, 7},
+		{"prostředí přepíše default", cfg.Crawler.SkipOn, []string{"http5xx", "ratelimit"}},
+		{"parametr přepíše prostředí", cfg.Crawler.Retries, 8},
+		{"soubor bez prostředí", cfg.Server.Listen, ":9000"},
+	}
+	for _, tt := range tests {
+		if !reflect.DeepEqual(tt.got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestLoadWithoutFile(t *testing.T) {
+	cfg, err := Load("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(cfg, Default()) {
+		t.Errorf("Load(\"\") = %+v, want Default()", cfg)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"neznámý klíč", "crawler:\n  worker: 3\n", "field worker not found"},
+		{"neznámá sekce", "crawlr:\n  workers: 3\n", "field crawlr not found"},
+		{"špatný typ", "crawler:\n  workers: hodně\n", "cannot unmarshal"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(writeConfig(t, tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Load = %v, want error containing %q", err, tt.want)
+			}
+		})
+	}
+
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("Load(missing file): expected error")
+	}
+	// Prázdný soubor je platný a nechá výchozí hodnoty
+	if cfg, err := Load(writeConfig(t, "")); err != nil || !reflect.DeepEqual(cfg, Default()) {
+		t.Errorf("Load(empty file) = %+v, %v", cfg, err)
+	}
+}
+
+func TestApplyEnv(t *testing.T) {
+	env := map[string]string{
+		"PORT":                             "3000",
+		"TRACKERS":                         "udp://old",
+		"SKTORRENT_DB_PATH":                "/data/t.db",
+		"SKTORRENT_CRAWLER_WORKERS":        "4",
+		"SKTORRENT_CRAWLER_FIXED_WORKERS":  "true",
+		"SKTORRENT_CRAWLER_TARGET_LATENCY": "500ms",
+		"SKTORRENT_CRAWLER_TIMEOUT":        "1m",
+		"SKTORRENT_CRAWLER_RATE":           "1.5",
+		"SKTORRENT_CRAWLER_BURST":          "3",
+		"SKTORRENT_CRAWLER_ROBOTS":         "1",
+		"SKTORRENT_CRAWLER_RETRIES":        "2",
+		"SKTORRENT_CRAWLER_MAX_ERRORS":     "10",
+		"SKTORRENT_CRAWLER_ABORT_ON":       "http4xx,parse",
+		"SKTORRENT_CRAWLER_SKIP_ON":        "network",
+		"SKTORRENT_CRAWLER_DETAILS":        "true",
+		"SKTORRENT_CRAWLER_INCREMENTAL":    "true",
+		"SKTORRENT_CRAWLER_BASE_URL":       "http://localhost/torrents_v2.php",
+		"SKTORRENT_CRAWLER_ARCHIVE":        "pages.db",
+		"SKTORRENT_SERVER_CORS_ORIGINS":    "https://a.example, ,https://b.example",
+		"SKTORRENT_LOG_PROGRESS":           "true",
+		"SKTORRENT_LOG_EVENTS":             "events.jsonl",
+		"SKTORRENT_LOG_REQUESTS":           "false",
+		"SKTORRENT_TRACKERS":               "udp://a,udp://b",
+	}
+	cfg := Default()
+	if err := cfg.applyEnv(func(name string) (string, bool) {
+		v, ok := env[name]
+		return v, ok
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := Default()
+	want.Database.Path = "/data/t.db"
+	want.Crawler = CrawlerConfig{
+		Workers:       4,
+		FixedWorkers:  true,
+		TargetLatency: 500 * time.Millisecond,
+		Timeout:       time.Minute,
+		Rate:          1.5,
+		Burst:         3,
+		Robots:        true,
+		Retries:       2,
+		MaxErrors:     10,
+		AbortOn:       []string{"http4xx", "parse"},
+		SkipOn:        []string{"network"},
+		Details:       true,
+		Incremental:   true,
+		BaseURL:       "http://localhost/torrents_v2.php",
+		Archive:       "pages.db",
+	}
+	// PORT platí, dokud ho nepřepíše SKTORRENT_SERVER_LISTEN
+	want.Server = ServerConfig{Listen: ":3000", CORSOrigins: []string{"https://a.example", "https://b.example"}}
+	want.Log = LogConfig{Progress: true, Events: "events.jsonl", Requests: false}
+	// SKTORRENT_TRACKERS má přednost před TRACKERS
+	want.Trackers = []string{"udp://a", "udp://b"}
+
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("applyEnv:\n got %+v\nwant %+v", cfg, want)
+	}
+}
+
+func TestApplyEnvInvalid(t *testing.T) {
+	env := map[string]string{
+		"SKTORRENT_CRAWLER_WORKERS":    "tři",
+		"SKTORRENT_CRAWLER_TIMEOUT":    "30",
+		"SKTORRENT_CRAWLER_RATE":       "rychle",
+		"SKTORRENT_CRAWLER_ROBOTS":     "ano",
+		"SKTORRENT_CRAWLER_MAX_ERRORS": "5.5",
+	}
+	cfg := Default()
+	err := cfg.applyEnv(func(name string) (string, bool) {
+		v, ok := env[name]
+		return v, ok
+	})
+	if err == nil {
+		t.Fatal("applyEnv: expected error")
+	}
+	// Chyby se hlásí všechny najednou
+	for name := range env {
+		if !strings.Contains(err.Error(), name) {
+			t.Errorf("error %q does not mention %s", err, name)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		want   string // část chyby, "" = platné
+	}{
+		{"výchozí", func(c *Config) {}, ""},
+		{"prázdná databáze", func(c *Config) { c.Database.Path = "" }, "database.path is empty"},
+		{"žádný worker", func(c *Config) { c.Crawler.Workers = 0 }, "crawler.workers"},
+		{"moc workerů", func(c *Config) { c.Crawler.Workers = 21 }, "crawler.workers"},
+		{"nulová latence", func(c *Config) { c.Crawler.TargetLatency = 0 }, "crawler.target_latency"},
+		{"nulový timeout", func(c *Config) { c.Crawler.Timeout = 0 }, "crawler.timeout"},
+		{"záporný rate", func(c *Config) { c.Crawler.Rate = -1 }, "crawler.rate"},
+		{"nulový burst", func(c *Config) { c.Crawler.Burst = 0 }, "crawler.burst"},
+		{"žádný pokus", func(c *Config) { c.Crawler.Retries = 0 }, "crawler.retries"},
+		{"nulový limit chyb", func(c *Config) { c.Crawler.MaxErrors = 0 }, "crawler.max_errors"},
+		{"relativní base_url", func(c *Config) { c.Crawler.BaseURL = "/torrents_v2.php" }, "crawler.base_url"},
+		{"base_url bez http", func(c *Config) { c.Crawler.BaseURL = "ftp://sktorrent.eu/x" }, "crawler.base_url"},
+		{"prázdný listen", func(c *Config) { c.Server.Listen = "" }, "server.listen"},
+		{"origin s cestou", func(c *Config) { c.Server.CORSOrigins = []string{"https://a.example/app"} }, "server.cors_origins"},
+		{"origin bez schématu", func(c *Config) { c.Server.CORSOrigins = []string{"a.example"} }, "server.cors_origins"},
+		{"platné originy", func(c *Config) { c.Server.CORSOrigins = []string{"*", "http://localhost:3000"} }, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			tt.modify(&cfg)
+			err := cfg.Validate()
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("Validate = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Validate = %v, want error containing %q", err, tt.want)
+			}
+		})
+	}
+
+	// Všechny problémy najednou
+	cfg := Default()
+	cfg.Database.Path = ""
+	cfg.Crawler.Workers = 0
+	if err := cfg.Validate(); err == nil || strings.Count(err.Error(), "\n") != 1 {
+		t.Errorf("Validate = %v, want two errors", err)
+	}
+}
+
+func TestPathFromArgs(t *testing.T) {
+	t.Setenv("SKTORRENT_CONFIG", "env.yaml")
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"-config=a.yaml"}, "a.yaml"},
+		{[]string{"--config", "b.yaml", "-db=x"}, "b.yaml"},
+		{[]string{"-db=x", "-config", "c.yaml"}, "c.yaml"},
+		{[]string{"-db=x"}, "env.yaml"},
+		{[]string{"--", "-config=d.yaml"}, "env.yaml"},
+		{[]string{"config=e.yaml"}, "env.yaml"},
+	}
+	for _, tt := range tests {
+		if got := PathFromArgs(tt.args); got != tt.want {
+			t.Errorf("PathFromArgs(%q) = %q, want %q", tt.args, got, tt.want)
+		}
+	}
+}
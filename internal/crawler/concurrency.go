@@ -0,0 +1,147 @@
+package crawler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ConcurrencyPolicy řídí, kolik stránek se stahuje souběžně. Crawling začne
+// s Initial workery a přidává po jednom, dokud odpovědi chodí rychleji než
+// TargetLatency; při 429, 5xx nebo síťové chybě (timeout) souběžnost
+// vynásobí Backoff (AIMD). Horní mez je Config.Workers.
+type ConcurrencyPolicy struct {
+	Fixed         bool          // vždy Config.Workers, bez přizpůsobování
+	Initial       int           // počáteční souběžnost (default 1)
+	TargetLatency time.Duration // pomalejší odpovědi souběžnost nezvyšují (default 2s)
+	Backoff       float64       // násobek při přetížení serveru (default 0.5)
+}
+
+// DefaultConcurrencyPolicy začíná jedním workerem a při přetížení ubere polovinu
+var DefaultConcurrencyPolicy = ConcurrencyPolicy{
+	Initial:       1,
+	TargetLatency: 2 * time.Second,
+	Backoff:       0.5,
+}
+
+// concurrencyController je semafor s proměnlivým limitem. Zvýšení přijde
+// po limit rychlých úspěšných odpovědích (jedno "kolo"), snížení nejvýš
+// jednou za kolo - odpovědi na požadavky odeslané před posledním snížením
+// se nepočítají.
+type concurrencyController struct {
+	policy ConcurrencyPolicy
+	max    int
+
+	mu        sync.Mutex
+	cond      *sync.Cond
+	limit     int
+	active    int
+	successes int
+	epoch     int // zvyšuje se s každou změnou limitu
+	peak      int
+	backoffs  int
+}
+
+func newConcurrencyController(policy ConcurrencyPolicy, max int) *concurrencyController {
+	initial := policy.Initial
+	if policy.Fixed || initial > max {
+		initial = max
+	}
+	cc := &concurrencyController{policy: policy, max: max, limit: initial, peak: initial}
+	cc.cond = sync.NewCond(&cc.mu)
+	return cc
+}
+
+// acquire počká na volné místo pod aktuálním limitem
+func (cc *concurrencyController) acquire(ctx context.Context) error {
+	stop := context.AfterFunc(ctx, func() {
+		cc.mu.Lock()
+		defer cc.mu.Unlock()
+		cc.cond.Broadcast()
+	})
+	defer stop()
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	for cc.active >= cc.limit {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		cc.cond.Wait()
+	}
+	cc.active++
+	return nil
+}
+
+func (cc *concurrencyController) release() {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	cc.active--
+	cc.cond.Signal()
+}
+
+// begin označí začátek požadavku; výsledek se pak předá observe
+func (cc *concurrencyController) begin() int {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	return cc.epoch
+}
+
+// observe započítá výsledek požadavku. Když se limit změnil, vrátí nový
+// limit a důvod pro EventConcurrency.
+func (cc *concurrencyController) observe(epoch int, latency time.Duration, err error) (int, string, bool) {
+	if cc.policy.Fixed {
+		return 0, "", false
+	}
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	if err != nil {
+		switch ClassifyError(err) {
+		case ErrorClassRateLimited, ErrorClassHTTPServer, ErrorClassNetwork:
+		default:
+			return 0, "", false
+		}
+		if epoch != cc.epoch {
+			return 0, "", false
+		}
+		next := max(1, int(float64(cc.limit)*cc.policy.Backoff))
+		cc.successes = 0
+		cc.epoch++
+		cc.backoffs++
+		if next == cc.limit {
+			return 0, "", false
+		}
+		cc.limit = next
+		return next, fmt.Sprintf("%s, snižuji", ClassifyError(err)), true
+	}
+
+	if latency > cc.policy.TargetLatency || cc.limit >= cc.max {
+		return 0, "", false
+	}
+	cc.successes++
+	if cc.successes < cc.limit {
+		return 0, "", false
+	}
+	cc.successes = 0
+	cc.epoch++
+	cc.limit++
+	cc.peak = max(cc.peak, cc.limit)
+	cc.cond.Signal()
+	return cc.limit, fmt.Sprintf("odpovědi do %v, přidávám", cc.policy.TargetLatency), true
+}
+
+// current vrátí aktuální limit
+func (cc *concurrencyController) current() int {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	return cc.limit
+}
+
+// stats vrátí aktuální a nejvyšší limit a počet snížení
+func (cc *concurrencyController) stats() (limit, peak, backoffs int) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	return cc.limit, cc.peak, cc.backoffs
+}
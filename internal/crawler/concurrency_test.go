@@ -0,0 +1,209 @@
+package crawler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var (
+	fast     = 100 * time.Millisecond // pod TargetLatency testovací politiky
+	slow     = 5 * time.Second        // nad TargetLatency
+	tooMany  = &HTTPStatusError{StatusCode: http.StatusTooManyRequests}
+	timedOut = &NetworkError{URL: "https://sktorrent.eu/", Err: context.DeadlineExceeded}
+)
+
+func testController(initial, max int) *concurrencyController {
+	return newConcurrencyController(ConcurrencyPolicy{Initial: initial, TargetLatency: time.Second, Backoff: 0.5}, max)
+}
+
+// succeed započítá n rychlých úspěšných odpovědí z aktuálního kola
+func succeed(cc *concurrencyController, n int) {
+	for range n {
+		cc.observe(cc.begin(), fast, nil)
+	}
+}
+
+func TestConcurrencyAdditiveIncrease(t *testing.T) {
+	cc := testController(1, 10)
+
+	// Kolo má tolik odpovědí, kolik je limit: 1, pak 2, pak 3
+	for limit := 1; limit <= 3; limit++ {
+		succeed(cc, limit-1)
+		if got := cc.current(); got != limit {
+			t.Fatalf("after %d of %d successes limit = %d, want %d", limit-1, limit, got, limit)
+		}
+		next, reason, changed := cc.observe(cc.begin(), fast, nil)
+		if !changed || next != limit+1 || reason == "" {
+			t.Fatalf("observe = %d, %q, %v; want %d", next, reason, changed, limit+1)
+		}
+	}
+
+	// Pomalé odpovědi limit nezvyšují ani se nepočítají do kola
+	for range 10 {
+		if _, _, changed := cc.observe(cc.begin(), slow, nil); changed {
+			t.Fatal("slow response changed the limit")
+		}
+	}
+	if got := cc.current(); got != 4 {
+		t.Errorf("limit after slow responses = %d, want 4", got)
+	}
+
+	// Chyby, které neznamenají přetížení, limit nemění
+	for _, err := range []error{&HTTPStatusError{StatusCode: 404}, &ParseError{}, &LayoutChangedError{}} {
+		if _, _, changed := cc.observe(cc.begin(), fast, err); changed {
+			t.Errorf("%v changed the limit", err)
+		}
+	}
+	if got := cc.current(); got != 4 {
+		t.Errorf("limit after non-overload errors = %d, want 4", got)
+	}
+}
+
+func TestConcurrencyMultiplicativeDecrease(t *testing.T) {
+	for _, err := range []error{tooMany, &HTTPStatusError{StatusCode: 503}, timedOut} {
+		t.Run(ClassifyError(err).String(), func(t *testing.T) {
+			cc := testController(8, 10)
+			next, reason, changed := cc.observe(cc.begin(), fast, err)
+			if !changed || next != 4 {
+				t.Fatalf("observe = %d, %v; want 4", next, changed)
+			}
+			if reason == "" {
+				t.Error("empty reason")
+			}
+			if _, _, backoffs := cc.stats(); backoffs != 1 {
+				t.Errorf("backoffs = %d, want 1", backoffs)
+			}
+		})
+	}
+}
+
+func TestConcurrencyOneBackoffPerEpoch(t *testing.T) {
+	cc := testController(8, 10)
+
+	// Čtyři požadavky odeslané před snížením selžou všechny najednou
+	epochs := []int{cc.begin(), cc.begin(), cc.begin(), cc.begin()}
+	for i, epoch := range epochs {
+		_, _, changed := cc.observe(epoch, fast, tooMany)
+		if changed != (i == 0) {
+			t.Errorf("response %d: changed = %v", i, changed)
+		}
+	}
+	if got := cc.current(); got != 4 {
+		t.Errorf("limit = %d, want 4 (one backoff)", got)
+	}
+
+	// Požadavek odeslaný po snížení smí snížit znovu
+	if next, _, changed := cc.observe(cc.begin(), fast, tooMany); !changed || next != 2 {
+		t.Errorf("next epoch observe = %d, %v; want 2", next, changed)
+	}
+
+	// Úspěchy ze starého kola se po snížení počítají do nového
+	cc = testController(2, 10)
+	old := cc.begin()
+	cc.observe(cc.begin(), fast, tooMany) // 2 -> 1
+	if next, _, changed := cc.observe(old, fast, nil); !changed || next != 2 {
+		t.Errorf("success after backoff = %d, %v; want 2", next, changed)
+	}
+}
+
+func TestConcurrencyBounds(t *testing.T) {
+	// Horní mez: limit nepřekročí max
+	cc := testController(1, 3)
+	succeed(cc, 100)
+	if limit, peak, _ := cc.stats(); limit != 3 || peak != 3 {
+		t.Errorf("limit, peak = %d, %d; want 3, 3", limit, peak)
+	}
+
+	// Dolní mez: limit neklesne pod 1 a další snížení nic nehlásí
+	cc = testController(2, 3)
+	cc.observe(cc.begin(), fast, tooMany)
+	if _, _, changed := cc.observe(cc.begin(), fast, tooMany); changed {
+		t.Error("backoff below 1 reported a change")
+	}
+	if got := cc.current(); got != 1 {
+		t.Errorf("limit = %d, want 1", got)
+	}
+
+	// Initial nad max začne na max
+	if got := testController(8, 3).current(); got != 3 {
+		t.Errorf("initial limit = %d, want 3", got)
+	}
+
+	// Fixed drží max bez ohledu na odpovědi
+	fixed := newConcurrencyController(ConcurrencyPolicy{Fixed: true, Initial: 1, Backoff: 0.5}, 5)
+	fixed.observe(fixed.begin(), fast, tooMany)
+	succeed(fixed, 10)
+	if got := fixed.current(); got != 5 {
+		t.Errorf("fixed limit = %d, want 5", got)
+	}
+}
+
+func TestConcurrencyAcquire(t *testing.T) {
+	cc := testController(1, 2)
+	ctx := context.Background()
+	if err := cc.acquire(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// Druhý worker čeká, dokud se neuvolní místo
+	var acquired atomic.Bool
+	done := make(chan error)
+	go func() {
+		err := cc.acquire(ctx)
+		acquired.Store(true)
+		done <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	if acquired.Load() {
+		t.Fatal("acquire did not block at the limit")
+	}
+	cc.release()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	// Zvýšení limitu pustí čekající workery
+	go func() { done <- cc.acquire(ctx) }()
+	time.Sleep(20 * time.Millisecond)
+	succeed(cc, 1) // 1 -> 2
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("raising the limit did not wake a blocked acquire")
+	}
+}
+
+func TestConcurrencyAcquireCanceled(t *testing.T) {
+	cc := testController(1, 1)
+	if err := cc.acquire(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- cc.acquire(ctx) }()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("acquire = %v, want context.Canceled", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("canceling ctx did not wake a blocked acquire")
+	}
+
+	// Zrušené acquire místo nezabralo
+	cc.release()
+	if err := cc.acquire(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
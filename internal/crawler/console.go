@@ -0,0 +1,144 @@
+package crawler
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// ConsoleObserver vypisuje průběh crawlingu jako čitelný text s emoji
+// (výchozí pozorovatel, když Config.Observer není vyplněný)
+type ConsoleObserver struct {
+	w io.Writer
+}
+
+func NewConsoleObserver(w io.Writer) *ConsoleObserver {
+	if w == nil {
+		w = os.Stdout
+	}
+	return &ConsoleObserver{w: w}
+}
+
+func (o *ConsoleObserver) Observe(e Event) {
+	switch e.Type {
+	case EventRunStarted:
+		if e.Resumed {
+			fmt.Fprintf(o.w, "🆔 Navazuji na běh #%d: zbývá %d stránek\n", e.RunID, e.Count)
+		} else if e.RunID != 0 {
+			fmt.Fprintf(o.w, "🆔 Běh #%d\n", e.RunID)
+		}
+	case EventPageStarted:
+		fmt.Fprintf(o.w, "Worker processing page %d...\n", e.Page)
+	case EventPageFailed:
+		fmt.Fprintf(o.w, "❌ CHYBA na stránce %d: %v\n", e.Page, e.Err)
+		if e.Count > 0 {
+			fmt.Fprintf(o.w, "⚠️  Consecutive error #%d\n", e.Count)
+		}
+	case EventTorrentNew, EventTorrentUpdated:
+		o.printTorrent(e)
+	case EventPageSaved:
+		fmt.Fprintf(o.w, "💾 Stránka %d uložena - %d torrentů\n", e.Page, e.Count)
+	case EventPageUnchanged:
+		fmt.Fprintf(o.w, "♻️  Stránka %d beze změny (%d torrentů) - přeskočena\n", e.Page, e.Count)
+	case EventRetry:
+		fmt.Fprintf(o.w, "🔁 %v, pokus %d/%d za %v\n", e.Err, e.Attempt, e.MaxAttempts, e.Delay.Round(time.Millisecond))
+	case EventConcurrency:
+		if e.Err != nil {
+			fmt.Fprintf(o.w, "🐌 Souběžnost %d (%s: %v)\n", e.Count, e.Message, e.Err)
+		} else {
+			fmt.Fprintf(o.w, "🐇 Souběžnost %d (%s)\n", e.Count, e.Message)
+		}
+	case EventError:
+		if e.Page != NoPage {
+			fmt.Fprintf(o.w, "⚠️  Chyba při %s (stránka %d): %v\n", e.Message, e.Page, e.Err)
+		} else {
+			fmt.Fprintf(o.w, "⚠️  Chyba při %s: %v\n", e.Message, e.Err)
+		}
+	case EventNotice:
+		fmt.Fprintf(o.w, "%s\n", e.Message)
+	case EventStopping:
+		switch e.Reason {
+		case StopCaughtUp:
+			fmt.Fprintf(o.w, "⏩ Stránka %d: %s\n", e.Page, e.Message)
+		case StopErrors:
+			fmt.Fprintf(o.w, "🚫 %s\n", e.Message)
+		}
+	case EventRunFinished:
+		o.printSummary(e.Summary)
+	}
+}
+
+func (o *ConsoleObserver) printTorrent(e Event) {
+	t := e.Torrent
+	mark := "✅"
+	if e.Type == EventTorrentNew {
+		mark = "🆕"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "  %s %s", mark, t.Name)
+	if t.CSFDRating != 0 {
+		fmt.Fprintf(&b, " (ČSFD: %d%%)", t.CSFDRating)
+	}
+	fmt.Fprintf(&b, " [%.1f MB]", t.SizeMB)
+	fmt.Fprintf(&b, " [S:%d L:%d]", t.Seeds, t.Leeches)
+	if !t.AddedDate.IsZero() {
+		fmt.Fprintf(&b, " [%s]", t.AddedDate.Format("02.01.06"))
+	}
+	fmt.Fprintln(o.w, b.String())
+}
+
+func (o *ConsoleObserver) printSummary(summary *Summary) {
+	if summary == nil {
+		return
+	}
+
+	switch {
+	case summary.LayoutErrors > 0:
+		fmt.Fprintf(o.w, "\n🧩 SKTORRENT ZMĚNIL LAYOUT - DATA NEJSOU SPOLEHLIVÁ! 🧩\n")
+		fmt.Fprintf(o.w, "⚠️  %v\n", summary.LayoutError)
+		fmt.Fprintf(o.w, "⚠️  Stránky se změněným layoutem: %d\n", summary.LayoutErrors)
+	case summary.StopReason == StopErrors:
+		fmt.Fprintf(o.w, "\n🚫 CRAWLING UKONČEN KVŮLI CHYBÁM! 🚫\n")
+		if summary.AbortError != nil {
+			fmt.Fprintf(o.w, "⚠️  Crawling byl zastaven chybou typu %s: %v\n", ClassifyError(summary.AbortError), summary.AbortError)
+		} else {
+			fmt.Fprintf(o.w, "⚠️  Crawling byl zastaven po %d po sobě jdoucích chybách\n", summary.ConsecutiveErrors)
+		}
+		fmt.Fprintf(o.w, "💾 Data byla uložena i přes chyby\n")
+	case summary.StopReason == StopInterrupted:
+		fmt.Fprintf(o.w, "\n⏹️  CRAWLING PŘERUŠEN - ČÁSTEČNÝ VÝSLEDEK\n")
+		fmt.Fprintf(o.w, "💾 Dokončené stránky byly uloženy\n")
+	case summary.StopReason == StopCaughtUp:
+		fmt.Fprintf(o.w, "\n🎉 CRAWLING DOKONČEN - DOHNÁNY ZNÁMÉ TORRENTY 🎉\n")
+	default:
+		fmt.Fprintf(o.w, "\n🎉 CRAWLING DOKONČEN! 🎉\n")
+	}
+
+	if summary.ListingPages > 0 {
+		fmt.Fprintf(o.w, "📚 Stránek ve výpisu: %d\n", summary.ListingPages)
+	}
+	fmt.Fprintf(o.w, "📊 Celkový počet torrentů: %d\n", summary.TotalTorrents)
+	fmt.Fprintf(o.w, "💾 Uloženo do databáze: %d (nových %d)\n", summary.SavedTorrents, summary.NewTorrents)
+	fmt.Fprintf(o.w, "❌ Stránky s chybami: %d\n", summary.ErrorPages)
+	if summary.UnchangedPages > 0 {
+		fmt.Fprintf(o.w, "♻️  Stránky beze změny: %d\n", summary.UnchangedPages)
+	}
+	if summary.Retries > 0 {
+		fmt.Fprintf(o.w, "🔁 Opakované požadavky: %d\n", summary.Retries)
+	}
+	fmt.Fprintf(o.w, "🔎 Detail stránky: staženo %d, přeskočeno %d\n", summary.DetailsFetched, summary.DetailsSkipped)
+	if summary.ErrorPages > 0 && summary.RunID != 0 {
+		fmt.Fprintf(o.w, "💡 Neúspěšné stránky lze zopakovat přes -retry-failed\n")
+	}
+	fmt.Fprintf(o.w, "⚙️  Souběžnost: na konci %d, nejvýš %d (limit %d)", summary.FinalWorkers, summary.PeakWorkers, summary.Workers)
+	if summary.Backoffs > 0 {
+		fmt.Fprintf(o.w, ", přetížení serveru %d×", summary.Backoffs)
+	}
+	fmt.Fprintln(o.w)
+	if summary.RunID != 0 && (summary.StopReason == StopInterrupted || summary.StopReason == StopErrors) {
+		fmt.Fprintf(o.w, "💡 Pokračovat lze přes -resume %d\n", summary.RunID)
+	}
+}
@@ -2,18 +2,18 @@ package crawler
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
-	"net/url"
-	"regexp"
-	"strconv"
-	"strings"
+	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/JaLe29/search-me-plz-sktorrent/internal/database"
-	"github.com/PuerkitoBio/goquery"
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/parser"
 )
 
 type Torrent struct {
@@ -21,44 +21,140 @@ type Torrent struct {
 	Name       string
 	Category   string
 	SizeMB     float64   // velikost v MB
-	AddedDate  time.Time // datum přidání
+	AddedDate  time.Time // datum přidání (nulový čas = nepodařilo se přečíst)
+	AddedRaw   string    // datum přidání, jak je na stránce
 	Seeds      int
 	Leeches    int
 	URL        string
 	ImageURL   string
-	CSFDRating int    // hodnocení jako číslo (77 místo "77%")
-	CSFDURL    string // přímý odkaz na ČSFD
+	CSFDRating int            // hodnocení jako číslo (77 místo "77%")
+	CSFDURL    string         // přímý odkaz na ČSFD
+	Detail     *parser.Detail // metadata z detail stránky (nil = nestahovalo se)
+	Known      bool           // torrent už byl v databázi před tímto crawlem
 }
 
 type CrawlResult struct {
 	PageNum  int
 	Torrents []Torrent
 	Error    error
+
+	// Unchanged znamená, že stránka je stejná jako při minulém uložení
+	// (304 nebo shodný hash těla); Torrents jsou pak prázdné
+	Unchanged bool
+	// Cache je stav stránky, který se uloží spolu s torrenty (nil = neukládat)
+	Cache *database.PageCache
+}
+
+// StopReason popisuje, proč crawling skončil před poslední stránkou
+type StopReason int
+
+const (
+	StopNone        StopReason = iota // crawling doběhl celý
+	StopErrors                        // příliš mnoho po sobě jdoucích chyb
+	StopInterrupted                   // přerušeno uživatelem (Stop nebo zrušený context)
+	StopCaughtUp                      // inkrementální crawling narazil na známé torrenty
+)
+
+var stopReasonNames = map[StopReason]string{
+	StopNone:        "none",
+	StopErrors:      "errors",
+	StopInterrupted: "interrupted",
+	StopCaughtUp:    "caught_up",
+}
+
+func (r StopReason) String() string {
+	if name, ok := stopReasonNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("StopReason(%d)", int(r))
+}
+
+// Summary shrnuje výsledek jednoho běhu Crawl
+type Summary struct {
+	TotalTorrents  int
+	SavedTorrents  int
+	NewTorrents    int // uložené torrenty, které v databázi ještě nebyly
+	ErrorPages     int
+	Retries        int // počet opakovaných HTTP požadavků
+	DetailsFetched int // stažené detail stránky
+	DetailsSkipped int // detail stránky přeskočené díky databázi
+	StopReason     StopReason
+	RunID          int64 // záznam v crawl_runs (0 bez databáze)
+	Workers        int   // horní mez souběžnosti (Config.Workers)
+	PeakWorkers    int   // nejvyšší souběžnost, na kterou se crawling dostal
+	FinalWorkers   int   // souběžnost na konci běhu
+	Backoffs       int   // kolikrát server ohlásil přetížení (429, 5xx, timeout)
+	UnchangedPages int   // stránky beze změny od minula (neparsovaly se ani neukládaly)
+	ListingPages   int   // počet stránek výpisu nalezený přes CrawlAll (0 = nezjišťoval se)
+
+	AbortError        error // chyba, která podle ErrorPolicy ukončila crawling
+	ConsecutiveErrors int   // počet chyb po sobě při ukončení kvůli chybám
+
+	LayoutErrors int   // stránky, které neprošly kontrolou kvality
+	LayoutError  error // první LayoutChangedError (nil = layout v pořádku)
 }
 
 type Config struct {
-	Workers   int
-	BaseURL   string
+	Workers   int          // max. počet souběžně stahovaných stránek
+	Listing   ListingQuery // který výpis se prochází (default: celý výpis od nejnovějších)
 	UserAgent string
 	Timeout   time.Duration
 	Database  *database.Database // databáze pro ukládání
+	Fetcher   Fetcher            // zdroj HTTP odpovědí (default: živé HTTP)
+	Observer  Observer           // kam hlásit průběh (default: ConsoleObserver na stdout)
+	RunKind   string             // druh běhu v crawl_runs (default: database.KindManual)
+
+	// Šetrnost k serveru
+	RateLimit     float64 // max. požadavků za sekundu na host (0 = bez limitu)
+	RateBurst     int     // kolik požadavků smí odejít najednou (default: 1)
+	RespectRobots bool    // stáhnout robots.txt a řídit se jím (včetně Crawl-delay)
+
+	// FetchDetails stáhne detail stránku pro každý torrent; jinak jen pro
+	// torrenty s ČSFD hodnocením (kvůli odkazu na ČSFD)
+	FetchDetails bool
+	// RefreshDetails stáhne detail i pro torrenty, které už v databázi
+	// máme beze změny názvu a hodnocení
+	RefreshDetails bool
+	// IgnorePageCache zpracuje i stránky, které se od minulého uložení
+	// nezměnily (bez podmíněných požadavků), např. po změně parseru
+	IgnorePageCache bool
+
+	// Inkrementální režim: výpis je seřazený od nejnovějších, takže crawling
+	// skončí na první stránce, která nepřináší nic nového
+	Incremental    bool
+	KnownThreshold int       // stačí K po sobě jdoucích známých torrentů (0 = celá stránka)
+	Since          time.Time // torrenty přidané před tímto datem se ignorují a crawling končí
+
+	Retry   RetryPolicy   // opakování neúspěšných požadavků (default: DefaultRetryPolicy)
+	Errors  ErrorPolicy   // co dělat s jednotlivými třídami chyb (default: DefaultErrorPolicy)
+	Quality QualityPolicy // kdy stránka znamená změnu layoutu (default: DefaultQualityPolicy)
+
+	// Concurrency přizpůsobuje počet souběžných stránek odezvě serveru
+	// (default: DefaultConcurrencyPolicy)
+	Concurrency ConcurrencyPolicy
 }
 
 type Crawler struct {
-	client    *http.Client
-	config    Config
-	csfdRegex *regexp.Regexp
+	fetcher Fetcher
+	config  Config
+	limiter *HostLimiter
+	robots  robotsCache
 	// Error tracking for consecutive failures
 	consecutiveErrors int
+	abortError        error // chyba, která podle ErrorPolicy ukončila crawling
 	errorMutex        sync.Mutex
-	stopCrawling      bool
+	stopReason        StopReason
 	stopMutex         sync.Mutex
+	retries           atomic.Int64
+	detailsFetched    atomic.Int64
+	detailsSkipped    atomic.Int64
+	runID             int64 // aktuální běh v crawl_runs
+	listingPages      int   // počet stránek výpisu z CrawlAll pro Summary
+	events            eventBus
+	concurrency       *concurrencyController // souběžnost aktuálního běhu
 }
 
 func NewCrawler(config Config) *Crawler {
-	if config.BaseURL == "" {
-		config.BaseURL = "https://sktorrent.eu/torrent/torrents_v2.php?active=0&order=data&by=DESC&zaner=&jazyk=&page=0"
-	}
 	if config.UserAgent == "" {
 		config.UserAgent = "Mozilla/5.0 (compatible; SkTorrent-Crawler/1.0)"
 	}
@@ -68,46 +164,138 @@ func NewCrawler(config Config) *Crawler {
 	if config.Workers <= 0 {
 		config.Workers = 3
 	}
-
-	// Regex pro parsování ČSFD hodnocení z názvu
-	csfdRegex := regexp.MustCompile(`=\s*CSFD\s*(\d+)%`)
+	if config.Observer == nil {
+		config.Observer = NewConsoleObserver(os.Stdout)
+	}
+	if config.Retry.MaxAttempts <= 0 {
+		config.Retry = DefaultRetryPolicy
+	}
+	if config.Errors.Rules == nil {
+		config.Errors.Rules = DefaultErrorPolicy().Rules
+	}
+	if config.Errors.MaxConsecutive <= 0 {
+		config.Errors.MaxConsecutive = DefaultErrorPolicy().MaxConsecutive
+	}
+	if config.Fetcher == nil {
+		config.Fetcher = NewHTTPFetcher(config.Timeout)
+	}
+	if config.Quality.MaxMissing <= 0 {
+		config.Quality.MaxMissing = DefaultQualityPolicy.MaxMissing
+	}
+	if config.Quality.MinRows <= 0 {
+		config.Quality.MinRows = DefaultQualityPolicy.MinRows
+	}
+	if config.Concurrency.Initial <= 0 {
+		config.Concurrency.Initial = DefaultConcurrencyPolicy.Initial
+	}
+	if config.Concurrency.TargetLatency <= 0 {
+		config.Concurrency.TargetLatency = DefaultConcurrencyPolicy.TargetLatency
+	}
+	if config.Concurrency.Backoff <= 0 || config.Concurrency.Backoff >= 1 {
+		config.Concurrency.Backoff = DefaultConcurrencyPolicy.Backoff
+	}
 
 	return &Crawler{
-		client: &http.Client{
-			Timeout: config.Timeout,
-		},
-		config:    config,
-		csfdRegex: csfdRegex,
+		fetcher: config.Fetcher,
+		config:  config,
+		limiter: NewHostLimiter(config.RateLimit, config.RateBurst),
+		robots:  robotsCache{hosts: make(map[string]*robotsEntry)},
+		events:  eventBus{observer: config.Observer},
+	}
+}
+
+// Crawl projde stránky from..to a uloží nalezené torrenty do databáze.
+// Zrušení ctx přeruší rozpracované HTTP požadavky i zápisy do DB; pro
+// šetrné ukončení (dokončit rozpracované stránky) použij Stop.
+func (c *Crawler) Crawl(ctx context.Context, from int, to int) Summary {
+	pages := make([]int, 0, to-from+1)
+	for pageNum := from; pageNum <= to; pageNum++ {
+		pages = append(pages, pageNum)
+	}
+	return c.CrawlPages(ctx, pages)
+}
+
+// CrawlPages projde zadané stránky (např. dříve neúspěšné) stejně jako Crawl
+func (c *Crawler) CrawlPages(ctx context.Context, pages []int) Summary {
+	c.runID = 0
+	if c.config.Database != nil && len(pages) > 0 {
+		runID, err := c.config.Database.CreateCrawlRun(ctx, c.config.RunKind, pages)
+		if err != nil {
+			c.emitError(NoPage, "zakládání běhu", err)
+		} else {
+			c.runID = runID
+		}
+	}
+
+	c.emit(Event{Type: EventRunStarted, Page: NoPage, Count: len(pages)})
+	return c.run(ctx, pages)
+}
+
+// Resume naváže na nedokončený běh a zpracuje jen stránky, které ještě
+// nejsou hotové
+func (c *Crawler) Resume(ctx context.Context, runID int64) (Summary, error) {
+	if c.config.Database == nil {
+		return Summary{}, fmt.Errorf("resuming run %d: no database configured", runID)
 	}
+
+	run, err := c.config.Database.GetCrawlRun(ctx, runID)
+	if err != nil {
+		return Summary{}, err
+	}
+	if run.Status == database.RunDone || run.Status == database.RunCaughtUp {
+		return Summary{}, fmt.Errorf("run %d is already finished (%s)", runID, run.Status)
+	}
+
+	pages, err := c.config.Database.GetPendingPages(ctx, runID)
+	if err != nil {
+		return Summary{}, err
+	}
+	if err := c.config.Database.ReopenCrawlRun(ctx, runID); err != nil {
+		return Summary{}, fmt.Errorf("reopening run %d: %w", runID, err)
+	}
+
+	c.runID = runID
+	c.emit(Event{Type: EventRunStarted, Page: NoPage, Count: len(pages), Resumed: true})
+	return c.run(ctx, pages), nil
 }
 
-func (c *Crawler) Crawl(from int, to int) {
+// run rozešle stránky workerům a zpracuje jejich výsledky
+func (c *Crawler) run(ctx context.Context, pages []int) Summary {
 	// Reset error tracking for new crawl
 	c.consecutiveErrors = 0
-	c.setStopCrawling(false)
+	c.abortError = nil
+	c.retries.Store(0)
+	c.detailsFetched.Store(0)
+	c.detailsSkipped.Store(0)
+	c.setStopCrawling(StopNone)
+	c.concurrency = newConcurrencyController(c.config.Concurrency, c.config.Workers)
 
 	// Vytvoření kanálů pro paralelní zpracování
-	jobs := make(chan int, to-from+1)
-	results := make(chan CrawlResult, to-from+1)
+	jobs := make(chan int)
+	results := make(chan CrawlResult, c.config.Workers)
 
-	// Spuštění workerů
+	// Spuštění workerů; stránky si bere jen tolik z nich, kolik dovolí
+	// aktuální souběžnost
 	var wg sync.WaitGroup
 	for i := 0; i < c.config.Workers; i++ {
 		wg.Add(1)
-		go c.worker(jobs, results, &wg)
+		go c.worker(ctx, jobs, results, &wg)
 	}
 
 	// Odeslání úkolů do kanálu
 	go func() {
-		for pageNum := from; pageNum <= to; pageNum++ {
+		defer close(jobs)
+		for _, pageNum := range pages {
 			// Check if crawling should stop
 			if c.shouldStopCrawling() {
-				fmt.Printf("🛑 Stopping job distribution due to consecutive errors\n")
-				break
+				return
+			}
+			select {
+			case jobs <- pageNum:
+			case <-ctx.Done():
+				return
 			}
-			jobs <- pageNum
 		}
-		close(jobs)
 	}()
 
 	// Čekání na dokončení všech workerů
@@ -117,379 +305,642 @@ func (c *Crawler) Crawl(from int, to int) {
 	}()
 
 	// Sbírání a zobrazení výsledků
-	c.processResults(results)
+	return c.processResults(ctx, pages, results)
+}
+
+// Stop přestane rozesílat nové stránky. Stránky, které už workery
+// zpracovávají, se dokončí a uloží.
+func (c *Crawler) Stop() {
+	c.setStopCrawling(StopInterrupted)
 }
 
-func (c *Crawler) worker(jobs <-chan int, results chan<- CrawlResult, wg *sync.WaitGroup) {
+func (c *Crawler) worker(ctx context.Context, jobs <-chan int, results chan<- CrawlResult, wg *sync.WaitGroup) {
 	defer wg.Done()
 
-	for pageNum := range jobs {
-		// Check if crawling should stop
-		if c.shouldStopCrawling() {
-			fmt.Printf("Worker stopping due to consecutive errors\n")
+	for {
+		if err := c.concurrency.acquire(ctx); err != nil {
 			return
 		}
-
-		fmt.Printf("Worker processing page %d...\n", pageNum)
-		torrents, err := c.crawlPage(pageNum)
-
-		// Record error and check if should stop
-		if c.recordError(err) {
-			// Send the result even if there's an error, so data can be saved
-			results <- CrawlResult{
-				PageNum:  pageNum,
-				Torrents: torrents,
-				Error:    err,
-			}
+		pageNum, ok := <-jobs
+		if !ok {
+			c.concurrency.release()
 			return
 		}
-
-		results <- CrawlResult{
-			PageNum:  pageNum,
-			Torrents: torrents,
-			Error:    err,
+		stop := c.workPage(ctx, pageNum, results)
+		c.concurrency.release()
+		if stop {
+			return
 		}
 	}
 }
 
-func (c *Crawler) crawlPage(pageNum int) ([]Torrent, error) {
-	url := fmt.Sprintf("%s&page=%d", c.config.BaseURL, pageNum)
+// workPage zpracuje jednu stránku; vrátí true, když má worker skončit
+func (c *Crawler) workPage(ctx context.Context, pageNum int, results chan<- CrawlResult) bool {
+	// Check if crawling should stop
+	if c.shouldStopCrawling() || ctx.Err() != nil {
+		return true
+	}
+
+	c.emit(Event{Type: EventPageStarted, Page: pageNum, URL: c.pageURL(pageNum)})
+	result, err := c.crawlPage(ctx, pageNum)
 
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
+	// Record error and check if should stop; the result is sent even
+	// if there's an error, so data can be saved
+	stop := c.recordError(pageNum, err)
+	result.PageNum = pageNum
+	result.Error = err
+	results <- result
+	return stop
+}
+
+// checkIncremental zastaví crawling, jakmile stránka nepřináší nic nového:
+// je prázdná, obsahuje jen známé torrenty se stejným datem přidání, nebo
+// sahá před Config.Since. Vrátí torrenty, které se mají uložit.
+func (c *Crawler) checkIncremental(pageNum int, torrents []Torrent, known map[string]database.KnownTorrent) []Torrent {
+	if len(torrents) == 0 {
+		c.stopCaughtUp(pageNum, "stránka je prázdná - konec výpisu")
+		return torrents
 	}
-	req.Header.Set("User-Agent", c.config.UserAgent)
 
-	response, err := c.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("fetching page %d: %w", pageNum, err)
+	if !c.config.Since.IsZero() {
+		var recent []Torrent
+		for _, t := range torrents {
+			// Torrent bez data nemůže výpis ukončit
+			if t.AddedDate.IsZero() || !t.AddedDate.Before(c.config.Since) {
+				recent = append(recent, t)
+			}
+		}
+		if len(recent) < len(torrents) {
+			c.stopCaughtUp(pageNum, fmt.Sprintf("sahá před %s - konec", c.config.Since.Format("02.01.2006")))
+		}
+		torrents = recent
 	}
-	defer response.Body.Close()
 
-	if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("page %d returned status %d", pageNum, response.StatusCode)
+	if len(torrents) == 0 {
+		return torrents
 	}
 
-	body, err := io.ReadAll(response.Body)
-	if err != nil {
-		return nil, fmt.Errorf("reading response body: %w", err)
+	// Počítáme po sobě jdoucí známé torrenty s nezměněným datem přidání
+	run, longest := 0, 0
+	for _, t := range torrents {
+		if k, ok := known[t.ID]; ok && k.AddedDate.Equal(t.AddedDate) {
+			run++
+		} else {
+			run = 0
+		}
+		if run > longest {
+			longest = run
+		}
 	}
 
-	// Parsování torrentů přímo z paměti
-	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
-	if err != nil {
-		return nil, fmt.Errorf("parsing HTML: %w", err)
+	threshold := c.config.KnownThreshold
+	if threshold <= 0 || threshold > len(torrents) {
+		threshold = len(torrents)
+	}
+	if longest >= threshold {
+		c.stopCaughtUp(pageNum, fmt.Sprintf("%d po sobě jdoucích známých torrentů - dohnáno", longest))
 	}
 
-	torrents := c.parseTorrents(doc)
-	return torrents, nil
+	return torrents
 }
 
-func (c *Crawler) parseTorrents(doc *goquery.Document) []Torrent {
-	var torrents []Torrent
+// stopCaughtUp ukončí inkrementální crawling a ohlásí proč
+func (c *Crawler) stopCaughtUp(pageNum int, message string) {
+	c.emit(Event{Type: EventStopping, Page: pageNum, Reason: StopCaughtUp, Message: message})
+	c.setStopCrawling(StopCaughtUp)
+}
 
-	doc.Find("TD.lista").Each(func(i int, s *goquery.Selection) {
-		// Zkontrolovat, zda obsahuje odkaz na details.php
-		detailsLink := s.Find("A[href*='details.php']")
-		if detailsLink.Length() == 0 {
-			return
-		}
+// lookupKnown najde torrenty stránky, které už jsou v databázi (jedním dotazem)
+func (c *Crawler) lookupKnown(ctx context.Context, pageNum int, torrents []Torrent) map[string]database.KnownTorrent {
+	if c.config.Database == nil || len(torrents) == 0 {
+		return nil
+	}
 
-		var torrent Torrent
+	ids := make([]string, len(torrents))
+	for i, t := range torrents {
+		ids[i] = t.ID
+	}
+	known, err := c.config.Database.GetKnownTorrents(ctx, ids)
+	if err != nil {
+		c.emitError(pageNum, "hledání známých torrentů", err)
+		return nil
+	}
+	for i := range torrents {
+		_, torrents[i].Known = known[torrents[i].ID]
+	}
+	return known
+}
 
-		// Název torrentu
-		torrent.Name = strings.TrimSpace(detailsLink.Text())
-		if torrent.Name == "" {
-			return
+// fetchDetails stáhne detail stránky jen tam, kde je potřeba: u nových
+// torrentů a u těch, kterým se změnil název nebo hodnocení. Ostatním
+// doplní ČSFD odkaz z databáze. Config.RefreshDetails stahuje vždy.
+// Vrátí false, když se některý detail stáhnout nepodařilo.
+func (c *Crawler) fetchDetails(ctx context.Context, pageNum int, torrents []Torrent, known map[string]database.KnownTorrent) bool {
+	complete := true
+	for i := range torrents {
+		torrent := &torrents[i]
+		if torrent.CSFDRating == 0 && !c.config.FetchDetails {
+			continue
 		}
 
-		// URL a ID
-		if href, exists := detailsLink.Attr("href"); exists {
-			torrent.URL = "https://sktorrent.eu/torrent/" + href
-			// Extrakce ID z URL parametrů
-			torrent.ID = c.extractTorrentID(href)
+		if k, ok := known[torrent.ID]; ok && !c.config.RefreshDetails &&
+			k.Name == torrent.Name && k.CSFDRating == torrent.CSFDRating &&
+			(k.CSFDURL != "" || torrent.CSFDRating == 0) &&
+			(k.HasDetails || !c.config.FetchDetails) {
+			torrent.CSFDURL = k.CSFDURL
+			c.detailsSkipped.Add(1)
+			continue
 		}
 
-		// ČSFD hodnocení z názvu
-		torrent.CSFDRating = c.parseCSFDRating(torrent.Name)
-
-		// Kategorie
-		categoryLink := s.Find("a[href*='torrents_v2.php?category=']")
-		if categoryLink.Length() > 0 {
-			torrent.Category = strings.TrimSpace(categoryLink.Text())
+		if detail, ok := c.fetchDetail(ctx, torrent.URL); ok {
+			torrent.Detail = &detail
+			torrent.CSFDURL = detail.CSFDURL
+			c.detailsFetched.Add(1)
+			c.emit(Event{Type: EventDetailFetched, Page: pageNum, URL: torrent.URL, Torrent: torrent})
+		} else {
+			complete = complete && torrent.URL == ""
+			if k, ok := known[torrent.ID]; ok {
+				// Při chybě raději ponecháme, co už víme
+				torrent.CSFDURL = k.CSFDURL
+			}
 		}
+	}
+	return complete
+}
 
-		// URL obrázku
-		imgElement := s.Find("img.lozad")
-		if imgElement.Length() > 0 {
-			if dataSrc, exists := imgElement.Attr("data-src"); exists {
-				torrent.ImageURL = dataSrc
-			}
+// pageURL vrátí URL výpisu pro danou stránku
+func (c *Crawler) pageURL(pageNum int) string {
+	return c.config.Listing.URL(pageNum)
+}
+
+// crawlPage stáhne a zpracuje jednu stránku výpisu. Stránku, která se od
+// minulého uložení nezměnila, vrátí jako Unchanged bez parsování. Ve výpisu
+// od nejnovějších se obsah stránek posouvá s každým uploadem, takže to
+// nastane hlavně při opakování krátce po sobě (viz lookupPageCache).
+func (c *Crawler) crawlPage(ctx context.Context, pageNum int) (CrawlResult, error) {
+	url := c.pageURL(pageNum)
+	cached := c.lookupPageCache(ctx, pageNum, url)
+
+	resp, err := c.fetch(ctx, url, conditionalHeader(cached))
+	if err != nil {
+		return CrawlResult{}, fmt.Errorf("page %d: %w", pageNum, err)
+	}
+
+	state := &database.PageCache{
+		URL:          url,
+		ETag:         resp.header.Get("ETag"),
+		LastModified: resp.header.Get("Last-Modified"),
+	}
+	if !resp.notModified {
+		state.BodyHash = bodyHash(resp.body)
+	}
+	if cached != nil && (resp.notModified || state.BodyHash == cached.BodyHash) {
+		if c.config.Incremental {
+			c.stopCaughtUp(pageNum, "stránka se od minula nezměnila - dohnáno")
 		}
+		state.TorrentCount = cached.TorrentCount
+		return CrawlResult{Unchanged: true, Cache: state}, nil
+	}
 
-		// Velikost, seeders, leechers
-		c.parseMetadata(s, &torrent)
+	// Parsování torrentů přímo z paměti
+	page, err := parser.ParseListingPage(bytes.NewReader(resp.body), time.Now())
+	if err != nil {
+		return CrawlResult{}, fmt.Errorf("page %d: %w", pageNum, &ParseError{URL: url, Err: err})
+	}
+	if reason := c.config.Quality.checkQuality(pageNum, page); reason != "" {
+		return CrawlResult{}, fmt.Errorf("page %d: %w", pageNum, &LayoutChangedError{URL: url, Reason: reason})
+	}
 
-		// Vždy stáhnout přímý ČSFD odkaz z detail stránky (pokud má ČSFD hodnocení)
-		if torrent.CSFDRating != 0 {
-			torrent.CSFDURL = c.fetchCSFDURL(torrent.URL)
+	torrents := make([]Torrent, 0, len(page.Listings))
+	for _, l := range page.Listings {
+		torrents = append(torrents, torrentFromListing(l))
+	}
+	c.emit(Event{Type: EventPageParsed, Page: pageNum, URL: url, Count: len(torrents)})
+
+	known := c.lookupKnown(ctx, pageNum, torrents)
+	if c.config.Incremental {
+		listed := len(torrents)
+		torrents = c.checkIncremental(pageNum, torrents, known)
+		if len(torrents) < listed {
+			// Torrenty starší než Since se neuloží; se stavem by je plný
+			// crawl považoval za uložené a stránku přeskočil
+			state = nil
 		}
+	}
+	if !c.fetchDetails(ctx, pageNum, torrents, known) {
+		// Bez stavu se stránka příště zpracuje znovu a chybějící detaily doplní
+		state = nil
+	} else if state != nil {
+		state.TorrentCount = len(torrents)
+	}
 
-		torrents = append(torrents, torrent)
-	})
+	return CrawlResult{Torrents: torrents, Cache: state}, nil
+}
 
-	return torrents
+// fetchResponse je úspěšná odpověď (200, nebo 304 na podmíněný požadavek)
+type fetchResponse struct {
+	body        []byte
+	header      http.Header
+	notModified bool // 304 - obsah se od minula nezměnil, body je prázdné
 }
 
-func (c *Crawler) extractTorrentID(href string) string {
-	// href vypadá jako: details.php?name=...&id=339688748bd23e2ec25945937872287be91343f9
-	u, err := url.Parse(href)
+// get stáhne URL přes nakonfigurovaný Fetcher s ohledem na rate limit
+// a robots.txt a vrátí tělo odpovědi. Chyby vrací typované (HTTPStatusError,
+// NetworkError); třídy s ErrorRule.Retry opakuje podle Config.Retry.
+func (c *Crawler) get(ctx context.Context, rawURL string) ([]byte, error) {
+	resp, err := c.fetch(ctx, rawURL, nil)
 	if err != nil {
-		return ""
+		return nil, err
 	}
-	return u.Query().Get("id")
+	return resp.body, nil
 }
 
-func (c *Crawler) parseCSFDRating(name string) int {
-	matches := c.csfdRegex.FindStringSubmatch(name)
-	if len(matches) >= 2 {
-		rating, err := strconv.Atoi(matches[1])
+// fetch je get s dalšími hlavičkami požadavku (podmíněný GET), který vrací
+// i hlavičky odpovědi
+func (c *Crawler) fetch(ctx context.Context, rawURL string, header http.Header) (fetchResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", rawURL, nil)
+	if err != nil {
+		return fetchResponse{}, fmt.Errorf("creating request: %w", err)
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	req.Header.Set("User-Agent", c.config.UserAgent)
+
+	if c.config.RespectRobots {
+		if err := c.checkRobots(ctx, req.URL); err != nil {
+			return fetchResponse{}, err
+		}
+	}
+
+	policy := c.config.Retry
+	for attempt := 1; ; attempt++ {
+		if err := c.limiter.Wait(ctx, req.URL.Host); err != nil {
+			return fetchResponse{}, err
+		}
+
+		resp, err := c.timedFetch(req)
 		if err == nil {
-			return rating
+			return resp, nil
+		}
+		if attempt >= policy.MaxAttempts || ctx.Err() != nil || !c.config.Errors.rule(err).Retry {
+			return fetchResponse{}, err
+		}
+
+		delay := policy.backoff(attempt)
+		// Server nám může říct, jak dlouho máme počkat
+		var statusErr *HTTPStatusError
+		if errors.As(err, &statusErr) && statusErr.RetryAfter > delay {
+			delay = statusErr.RetryAfter
+		}
+		c.emit(Event{
+			Type:        EventRetry,
+			Page:        NoPage,
+			URL:         rawURL,
+			Err:         err,
+			Attempt:     attempt + 1,
+			MaxAttempts: policy.MaxAttempts,
+			Delay:       delay,
+		})
+		c.retries.Add(1)
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return fetchResponse{}, err
 		}
 	}
-	return 0
 }
 
-func (c *Crawler) fetchCSFDURL(detailURL string) string {
-	if detailURL == "" {
-		return ""
+// timedFetch je fetchOnce, jehož odezva a výsledek řídí souběžnost
+func (c *Crawler) timedFetch(req *http.Request) (fetchResponse, error) {
+	cc := c.concurrency
+	if cc == nil {
+		// Mimo běh (robots.txt, hledání kategorie) se souběžnost neřídí
+		return c.fetchOnce(req)
 	}
 
-	req, err := http.NewRequest("GET", detailURL, nil)
-	if err != nil {
-		return ""
+	epoch := cc.begin()
+	start := time.Now()
+	resp, err := c.fetchOnce(req)
+	if limit, reason, changed := cc.observe(epoch, time.Since(start), err); changed {
+		c.emit(Event{Type: EventConcurrency, Page: NoPage, URL: req.URL.String(), Count: limit, Message: reason, Err: err})
 	}
-	req.Header.Set("User-Agent", c.config.UserAgent)
+	return resp, err
+}
 
-	resp, err := c.client.Do(req)
+// fetchOnce provede jeden pokus o stažení a přečte celé tělo odpovědi
+func (c *Crawler) fetchOnce(req *http.Request) (fetchResponse, error) {
+	rawURL := req.URL.String()
+
+	resp, err := c.fetcher.Fetch(req)
 	if err != nil {
-		return ""
+		return fetchResponse{}, &NetworkError{URL: rawURL, Err: err}
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotModified && isConditional(req) {
+		return fetchResponse{header: resp.Header, notModified: true}, nil
+	}
 	if resp.StatusCode != http.StatusOK {
-		return ""
+		return fetchResponse{}, &HTTPStatusError{
+			StatusCode: resp.StatusCode,
+			URL:        rawURL,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		}
 	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return ""
+		return fetchResponse{}, &NetworkError{URL: rawURL, Err: fmt.Errorf("reading response body: %w", err)}
 	}
+	return fetchResponse{body: body, header: resp.Header}, nil
+}
 
-	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
-	if err != nil {
-		return ""
+// torrentFromListing převede torrent z výpisu na Torrent
+func torrentFromListing(l parser.Listing) Torrent {
+	return Torrent{
+		ID:         l.ID,
+		Name:       l.Name,
+		Category:   l.Category,
+		SizeMB:     l.SizeMB,
+		Seeds:      l.Seeds,
+		Leeches:    l.Leeches,
+		AddedDate:  l.AddedDate,
+		AddedRaw:   l.AddedDateRaw,
+		URL:        l.URL,
+		ImageURL:   l.ImageURL,
+		CSFDRating: l.CSFDRating,
 	}
+}
 
-	// Hledat ČSFD odkaz pomocí různých selektorů
-	csfdSelectors := []string{
-		`a[itemprop="sameAs"][href*="csfd.cz"]`,
-		`a[href*="csfd.cz/film/"]`,
-		`a[href*="csfd.sk/film/"]`,
+// fetchDetail stáhne a zpracuje detail stránku torrentu
+func (c *Crawler) fetchDetail(ctx context.Context, detailURL string) (parser.Detail, bool) {
+	if detailURL == "" {
+		return parser.Detail{}, false
 	}
 
-	for _, selector := range csfdSelectors {
-		link := doc.Find(selector).First()
-		if link.Length() > 0 {
-			if href, exists := link.Attr("href"); exists {
-				return href
-			}
-		}
+	body, err := c.get(ctx, detailURL)
+	if err != nil {
+		return parser.Detail{}, false
 	}
 
-	return ""
+	detail, err := parser.ParseDetail(bytes.NewReader(body))
+	if err != nil {
+		return parser.Detail{}, false
+	}
+	return detail, true
 }
 
-func (c *Crawler) parseMetadata(s *goquery.Selection, torrent *Torrent) {
-	s.Find("*").Each(func(j int, textNode *goquery.Selection) {
-		text := textNode.Text()
-		if !strings.Contains(text, "Velkost") {
-			return
+// processResults ukládá stránky hned, jak je workery dokončí, každou v
+// jedné transakci. Události uložených stránek ale vypisuje v pořadí pages,
+// takže rychlejší stránky počkají na pomalejší.
+func (c *Crawler) processResults(ctx context.Context, pages []int, results <-chan CrawlResult) Summary {
+	var totals pageTotals
+	pending := make(map[int][]Event)
+	next := 0
+
+	flush := func() {
+		for ; next < len(pages); next++ {
+			events, ok := pending[pages[next]]
+			if !ok {
+				return
+			}
+			for _, e := range events {
+				c.emit(e)
+			}
+			delete(pending, pages[next])
 		}
+	}
 
-		lines := strings.Split(text, "\n")
-		for _, line := range lines {
-			line = strings.TrimSpace(line)
+	for result := range results {
+		pending[result.PageNum] = c.savePage(ctx, result, &totals)
+		flush()
+	}
 
-			if strings.HasPrefix(line, "Velkost") {
-				// Parsovat velikost a datum z řádku jako "Velkost: 6.9 GB | Pridany 02/07/2025"
-				c.parseSizeAndDate(line, torrent)
-			} else if strings.HasPrefix(line, "Odosielaju") {
-				seedText := strings.TrimSpace(strings.Replace(line, "Odosielaju :", "", 1))
-				fmt.Sscanf(seedText, "%d", &torrent.Seeds)
-			} else if strings.HasPrefix(line, "Stahuju") {
-				leechText := strings.TrimSpace(strings.Replace(line, "Stahuju :", "", 1))
-				fmt.Sscanf(leechText, "%d", &torrent.Leeches)
-			}
+	// Stránky, které se po zastavení už nestahovaly, přeskočíme
+	for ; next < len(pages); next++ {
+		for _, e := range pending[pages[next]] {
+			c.emit(e)
 		}
-	})
-}
-
-func (c *Crawler) parseSizeAndDate(line string, torrent *Torrent) {
-	// Očekáváme formát: "Velkost 6.9 GB | Pridany 02/07/2025"
-	parts := strings.Split(line, "|")
+	}
 
-	// Parsování velikosti
-	if len(parts) >= 1 {
-		sizePart := strings.TrimSpace(parts[0])
-		sizePart = strings.Replace(sizePart, "Velkost", "", 1)
-		sizePart = strings.TrimSpace(sizePart)
-		torrent.SizeMB = c.parseSizeMB(sizePart)
+	// Zrušený context bez explicitního Stop je také přerušení
+	if ctx.Err() != nil && !c.shouldStopCrawling() {
+		c.setStopCrawling(StopInterrupted)
 	}
 
-	// Parsování data
-	if len(parts) >= 2 {
-		datePart := strings.TrimSpace(parts[1])
-		datePart = strings.Replace(datePart, "Pridany", "", 1)
-		datePart = strings.TrimSpace(datePart)
-		torrent.AddedDate = c.parseAddedDate(datePart)
+	final, peak, backoffs := c.concurrency.stats()
+	summary := Summary{
+		TotalTorrents:     totals.torrents,
+		SavedTorrents:     totals.saved,
+		NewTorrents:       totals.new,
+		ErrorPages:        totals.errorPages,
+		Retries:           int(c.retries.Load()),
+		DetailsFetched:    int(c.detailsFetched.Load()),
+		DetailsSkipped:    int(c.detailsSkipped.Load()),
+		StopReason:        c.currentStopReason(),
+		RunID:             c.runID,
+		Workers:           c.config.Workers,
+		PeakWorkers:       peak,
+		FinalWorkers:      final,
+		Backoffs:          backoffs,
+		UnchangedPages:    totals.unchanged,
+		ListingPages:      c.listingPages,
+		AbortError:        c.abortError,
+		ConsecutiveErrors: c.consecutiveErrors,
+		LayoutErrors:      totals.layoutErrors,
+		LayoutError:       totals.layoutError,
 	}
+	c.finishRun(ctx, summary)
+	c.emit(Event{Type: EventRunFinished, Page: NoPage, Summary: &summary})
+
+	return summary
 }
 
-func (c *Crawler) parseSizeMB(sizeStr string) float64 {
-	// Parsování velikosti z formátu "6.9 GB", "1.2 TB", "500 MB" atd.
-	re := regexp.MustCompile(`([0-9.]+)\s*(GB|TB|MB|KB)`)
-	matches := re.FindStringSubmatch(sizeStr)
+// pageTotals sčítá výsledky stránek pro Summary
+type pageTotals struct {
+	torrents   int
+	saved      int
+	new        int
+	errorPages int
+	unchanged  int
 
-	if len(matches) != 3 {
-		return 0.0
-	}
+	layoutErrors int
+	layoutError  error
+}
 
-	value, err := strconv.ParseFloat(matches[1], 64)
-	if err != nil {
-		return 0.0
-	}
-
-	unit := strings.ToUpper(matches[2])
-	switch unit {
-	case "KB":
-		return value / 1024 // Convert KB to MB
-	case "MB":
-		return value
-	case "GB":
-		return value * 1024 // Convert GB to MB
-	case "TB":
-		return value * 1024 * 1024 // Convert TB to MB
-	default:
-		return value
+// savePage uloží výsledek jedné stránky v jedné transakci a vrátí události,
+// které se k ní mají vypsat. Chyby jednotlivých torrentů stránku neshodí,
+// selhání commitu ale zahodí celou stránku (v běhu zůstane pending).
+func (c *Crawler) savePage(ctx context.Context, result CrawlResult, totals *pageTotals) []Event {
+	pageNum := result.PageNum
+	// Stránku uložíme i po zrušení ctx - stažená data se nemají zahodit
+	ctx = context.WithoutCancel(ctx)
+
+	if result.Error != nil {
+		totals.errorPages++
+		if ClassifyError(result.Error) == ErrorClassLayout {
+			totals.layoutErrors++
+			if totals.layoutError == nil {
+				totals.layoutError = result.Error
+			}
+		}
+		// Chybu workery už ohlásily; zbývá ji zapsat pro -retry-failed a -resume
+		events, _ := c.inPageTx(ctx, pageNum, func(tx *database.Tx) []Event {
+			var errs []Event
+			if err := tx.MarkPageFailed(ctx, pageNum, c.pageURL(pageNum), result.Error.Error()); err != nil {
+				errs = append(errs, Event{Type: EventError, Page: pageNum, Message: "ukládání neúspěšné stránky", Err: err})
+			}
+			if err := c.recordCrawlPage(ctx, tx, result); err != nil {
+				errs = append(errs, Event{Type: EventError, Page: pageNum, Message: "zápisu stavu stránky", Err: err})
+			}
+			return errs
+		})
+		return events
 	}
-}
 
-func (c *Crawler) parseAddedDate(dateStr string) time.Time {
-	// Parsování data z formátu "02/07/2025"
-	layouts := []string{
-		"02/01/2006",
-		"2/1/2006",
-		"02/1/2006",
-		"2/01/2006",
+	if result.Unchanged {
+		return c.saveUnchangedPage(ctx, result, totals)
 	}
 
-	for _, layout := range layouts {
-		if parsedTime, err := time.Parse(layout, dateStr); err == nil {
-			return parsedTime
+	totals.torrents += len(result.Torrents)
+	failed := make(map[*Torrent]bool)
+	var errs []Event
+	if c.config.Database != nil {
+		var err error
+		errs, err = c.inPageTx(ctx, pageNum, func(tx *database.Tx) []Event {
+			var pageErrs []Event
+			failed, pageErrs = c.saveTorrents(ctx, tx, pageNum, result.Torrents)
+			if err := tx.ClearFailedPage(ctx, pageNum); err != nil {
+				pageErrs = append(pageErrs, Event{Type: EventError, Page: pageNum, Message: "odstraňování stránky ze seznamu chyb", Err: err})
+			}
+			if err := c.recordCrawlPage(ctx, tx, result); err != nil {
+				pageErrs = append(pageErrs, Event{Type: EventError, Page: pageNum, Message: "zápisu stavu stránky", Err: err})
+			}
+			// Stránku s neuloženými torrenty si nepamatujeme, příště se zpracuje znovu
+			if result.Cache != nil && len(failed) == 0 {
+				if err := tx.SavePageCache(ctx, *result.Cache); err != nil {
+					pageErrs = append(pageErrs, Event{Type: EventError, Page: pageNum, Message: "ukládání stavu stránky", Err: err})
+				}
+			}
+			return pageErrs
+		})
+		if err != nil {
+			// Bez commitu se z celé stránky neuložilo nic
+			for i := range result.Torrents {
+				failed[&result.Torrents[i]] = true
+			}
 		}
 	}
 
-	// Pokud se nepodaří parsovat, vrátíme aktuální čas
-	return time.Now()
-}
-
-func (c *Crawler) processResults(results <-chan CrawlResult) {
-	resultMap := make(map[int]CrawlResult)
-
-	// Sbírání všech výsledků
-	for result := range results {
-		resultMap[result.PageNum] = result
+	var events []Event
+	saved := 0
+	for i := range result.Torrents {
+		torrent := &result.Torrents[i]
+		// Torrenty, které se nepodařilo uložit, nevypisujeme
+		if failed[torrent] {
+			continue
+		}
+		if c.config.Database != nil {
+			saved++
+		}
+		eventType := EventTorrentUpdated
+		if !torrent.Known {
+			eventType = EventTorrentNew
+			totals.new++
+		}
+		events = append(events, Event{Type: eventType, Page: pageNum, Torrent: torrent})
 	}
+	totals.saved += saved
 
-	// Zpracování výsledků v pořadí
-	totalTorrents := 0
-	savedTorrents := 0
-	errorPages := 0
+	events = append(events, errs...)
+	return append(events, Event{Type: EventPageSaved, Page: pageNum, Count: saved})
+}
 
-	for pageNum := range resultMap {
-		result := resultMap[pageNum]
+// saveUnchangedPage zapíše stránku beze změny jako hotovou; torrenty ani
+// stats se nezapisují, aktualizuje se jen čas kontroly stránky
+func (c *Crawler) saveUnchangedPage(ctx context.Context, result CrawlResult, totals *pageTotals) []Event {
+	pageNum := result.PageNum
+	totals.unchanged++
 
-		if result.Error != nil {
-			fmt.Printf("❌ CHYBA na stránce %d: %v\n", result.PageNum, result.Error)
-			errorPages++
-			continue
+	events, _ := c.inPageTx(ctx, pageNum, func(tx *database.Tx) []Event {
+		var errs []Event
+		if err := tx.TouchPageCache(ctx, result.Cache.URL, result.Cache.ETag, result.Cache.LastModified); err != nil {
+			errs = append(errs, Event{Type: EventError, Page: pageNum, Message: "ukládání stavu stránky", Err: err})
 		}
+		if err := tx.ClearFailedPage(ctx, pageNum); err != nil {
+			errs = append(errs, Event{Type: EventError, Page: pageNum, Message: "odstraňování stránky ze seznamu chyb", Err: err})
+		}
+		if err := c.recordCrawlPage(ctx, tx, result); err != nil {
+			errs = append(errs, Event{Type: EventError, Page: pageNum, Message: "zápisu stavu stránky", Err: err})
+		}
+		return errs
+	})
+	return append(events, Event{Type: EventPageUnchanged, Page: pageNum, URL: result.Cache.URL, Count: result.Cache.TorrentCount})
+}
 
-		fmt.Printf("💾 UKLÁDÁNÍ STRÁNKY %d - %d TORRENTŮ\n", result.PageNum, len(result.Torrents))
-
-		// Uložení torrentů do databáze
-		for _, torrent := range result.Torrents {
-			if c.config.Database != nil {
-				// Uložit základní informace o torrentu
-				dbTorrent := c.convertToDBTorrent(torrent)
-				if err := c.config.Database.UpsertTorrent(&dbTorrent); err != nil {
-					fmt.Printf("⚠️  Chyba při ukládání torrentu %s: %v\n", torrent.ID, err)
-					continue
-				}
+// inPageTx spustí zápisy jedné stránky v transakci a k událostem z fn přidá
+// případnou chybu transakce
+func (c *Crawler) inPageTx(ctx context.Context, pageNum int, fn func(tx *database.Tx) []Event) ([]Event, error) {
+	if c.config.Database == nil {
+		return nil, nil
+	}
 
-				// Zaznamenat aktuální stats (seeds/leeches) s časovým razítkem
-				if err := c.config.Database.RecordTorrentStats(torrent.ID, torrent.Seeds, torrent.Leeches); err != nil {
-					fmt.Printf("⚠️  Chyba při ukládání stats pro %s: %v\n", torrent.ID, err)
-				}
+	var events []Event
+	err := c.config.Database.InTx(ctx, func(tx *database.Tx) error {
+		events = fn(tx)
+		return nil
+	})
+	if err != nil {
+		events = append(events, Event{Type: EventError, Page: pageNum, Message: "ukládání stránky", Err: err})
+	}
+	return events, err
+}
 
-				savedTorrents++
-			}
+// saveTorrents zapíše torrenty stránky a jejich aktuální stats jednou dávkou
+// a k uloženým torrentům metadata z detail stránky. Vrátí torrenty, které se
+// nepodařilo uložit, a události s chybami.
+func (c *Crawler) saveTorrents(ctx context.Context, tx *database.Tx, pageNum int, torrents []Torrent) (map[*Torrent]bool, []Event) {
+	failed := make(map[*Torrent]bool)
+	var errs []Event
+
+	dbTorrents := make([]database.Torrent, len(torrents))
+	stats := make([]database.StatsSample, len(torrents))
+	for i, torrent := range torrents {
+		dbTorrents[i] = c.convertToDBTorrent(torrent)
+		stats[i] = database.StatsSample{TorrentID: torrent.ID, Seeds: torrent.Seeds, Leeches: torrent.Leeches}
+	}
 
-			// Stručný výpis
-			fmt.Printf("  ✅ %s", torrent.Name)
-			if torrent.CSFDRating != 0 {
-				fmt.Printf(" (ČSFD: %d%%)", torrent.CSFDRating)
-			}
-			fmt.Printf(" [%.1f MB]", torrent.SizeMB)
-			fmt.Printf(" [S:%d L:%d]", torrent.Seeds, torrent.Leeches)
-			if !torrent.AddedDate.IsZero() {
-				fmt.Printf(" [%s]", torrent.AddedDate.Format("02.01.06"))
-			}
-			fmt.Printf("\n")
+	result, err := tx.SaveBatch(ctx, dbTorrents, stats)
+	if err != nil {
+		for i := range torrents {
+			failed[&torrents[i]] = true
 		}
-
-		totalTorrents += len(result.Torrents)
+		return failed, append(errs, Event{Type: EventError, Page: pageNum, Message: "ukládání torrentů", Err: err})
 	}
-
-	// Check if crawling was stopped due to consecutive errors
-	if c.shouldStopCrawling() {
-		fmt.Printf("\n🚫 CRAWLING UKONČEN KVŮLI CHYBÁM! 🚫\n")
-		fmt.Printf("⚠️  Crawling byl zastaven po %d po sobě jdoucích chybách (400/500 status)\n", c.consecutiveErrors)
-		fmt.Printf("💾 Data byla uložena i přes chyby\n")
-	} else {
-		fmt.Printf("\n🎉 CRAWLING DOKONČEN! 🎉\n")
+	for _, rowErr := range result.Failed {
+		torrent := &torrents[rowErr.Index]
+		switch {
+		case rowErr.Table == "torrents":
+			failed[torrent] = true
+			errs = append(errs, Event{Type: EventError, Page: pageNum, Torrent: torrent, Message: "ukládání torrentu " + torrent.ID, Err: rowErr.Err})
+		case !errors.Is(rowErr.Err, database.ErrTorrentNotSaved):
+			errs = append(errs, Event{Type: EventError, Page: pageNum, Torrent: torrent, Message: "ukládání stats pro " + torrent.ID, Err: rowErr.Err})
+		}
 	}
 
-	fmt.Printf("📊 Celkový počet torrentů: %d\n", totalTorrents)
-	fmt.Printf("💾 Uloženo do databáze: %d\n", savedTorrents)
-	fmt.Printf("❌ Stránky s chybami: %d\n", errorPages)
-	fmt.Printf("⚙️  Použito workerů: %d\n", c.config.Workers)
-
-	// Zobrazení statistik databáze
-	if c.config.Database != nil {
-		if stats, err := c.config.Database.GetStats(); err == nil {
-			fmt.Printf("\n📈 STATISTIKY DATABÁZE:\n")
-			fmt.Printf("  🗃️  Celkem torrentů: %d\n", stats["total"])
-			for category, count := range stats {
-				if category != "total" && count > 0 {
-					fmt.Printf("  📁 %s: %d\n", category, count)
-				}
-			}
+	// Metadata z detail stránky, pokud se stahovala
+	for i := range torrents {
+		torrent := &torrents[i]
+		if torrent.Detail == nil || failed[torrent] {
+			continue
+		}
+		details := c.convertToDBDetails(*torrent)
+		if err := tx.UpsertTorrentDetails(ctx, &details); err != nil {
+			errs = append(errs, Event{Type: EventError, Page: pageNum, Torrent: torrent, Message: "ukládání detailu " + torrent.ID, Err: err})
 		}
 	}
+
+	return failed, errs
 }
 
 // convertToDBTorrent převede crawler.Torrent na database.Torrent
@@ -500,6 +951,7 @@ func (c *Crawler) convertToDBTorrent(t Torrent) database.Torrent {
 		Category:   t.Category,
 		SizeMB:     t.SizeMB,
 		AddedDate:  t.AddedDate,
+		AddedRaw:   t.AddedRaw,
 		URL:        t.URL,
 		ImageURL:   t.ImageURL,
 		CSFDRating: t.CSFDRating,
@@ -507,45 +959,135 @@ func (c *Crawler) convertToDBTorrent(t Torrent) database.Torrent {
 	}
 }
 
-// recordError tracks consecutive errors and determines if crawling should stop
-func (c *Crawler) recordError(err error) bool {
+// convertToDBDetails převede detail torrentu na database.TorrentDetails
+func (c *Crawler) convertToDBDetails(t Torrent) database.TorrentDetails {
+	infoHash := t.Detail.InfoHash
+	if infoHash == "" {
+		// ID z URL je samo o sobě info-hash
+		infoHash = t.ID
+	}
+	return database.TorrentDetails{
+		TorrentID:      t.ID,
+		Description:    t.Detail.Description,
+		Uploader:       t.Detail.Uploader,
+		FileCount:      t.Detail.FileCount,
+		CompletedCount: t.Detail.CompletedCount,
+		IMDbURL:        t.Detail.IMDbURL,
+		TrailerURL:     t.Detail.TrailerURL,
+		Audio:          t.Detail.Audio,
+		Subtitles:      t.Detail.Subtitles,
+		InfoHash:       infoHash,
+	}
+}
+
+// recordCrawlPage zapíše stav stránky do aktuálního běhu
+func (c *Crawler) recordCrawlPage(ctx context.Context, tx *database.Tx, result CrawlResult) error {
+	if c.runID == 0 {
+		return nil
+	}
+
+	status, errMsg := database.PageDone, ""
+	if result.Error != nil {
+		status, errMsg = database.PageFailed, result.Error.Error()
+	}
+	return tx.RecordCrawlPage(ctx, c.runID, result.PageNum, status, len(result.Torrents), errMsg)
+}
+
+// finishRun uloží konečný stav běhu podle důvodu ukončení. Změna layoutu
+// má přednost, i když ji ErrorPolicy jen přeskočila.
+func (c *Crawler) finishRun(ctx context.Context, summary Summary) {
+	if c.config.Database == nil || c.runID == 0 {
+		return
+	}
+
+	status, errMsg := database.RunDone, ""
+	switch summary.StopReason {
+	case StopErrors:
+		status = database.RunStopped
+		if summary.AbortError != nil {
+			errMsg = summary.AbortError.Error()
+		}
+	case StopInterrupted:
+		status = database.RunInterrupted
+	case StopCaughtUp:
+		status = database.RunCaughtUp
+	}
+	if summary.LayoutError != nil {
+		status, errMsg = database.RunLayout, summary.LayoutError.Error()
+	}
+	if err := c.config.Database.FinishCrawlRun(context.WithoutCancel(ctx), c.runID, status, errMsg); err != nil {
+		c.emitError(NoPage, fmt.Sprintf("ukončování běhu #%d", c.runID), err)
+	}
+}
+
+// recordError applies the error policy to the result of one page and
+// determines if crawling should stop
+func (c *Crawler) recordError(pageNum int, err error) bool {
 	c.errorMutex.Lock()
 	defer c.errorMutex.Unlock()
 
-	// Check if this is an HTTP error (400 or 500 status)
-	if err != nil {
-		errStr := err.Error()
-		if strings.Contains(errStr, "status 4") || strings.Contains(errStr, "status 5") {
-			c.consecutiveErrors++
-			fmt.Printf("⚠️  Consecutive error #%d: %v\n", c.consecutiveErrors, err)
-
-			if c.consecutiveErrors >= 5 {
-				fmt.Printf("🚫 Stopping crawling after %d consecutive errors\n", c.consecutiveErrors)
-				c.setStopCrawling(true)
-				return true
-			}
-		} else {
-			// Reset counter for non-HTTP errors
-			c.consecutiveErrors = 0
-		}
-	} else {
+	if err == nil {
 		// Reset counter on successful request
 		c.consecutiveErrors = 0
+		return false
+	}
+
+	failed := Event{Type: EventPageFailed, Page: pageNum, URL: c.pageURL(pageNum), Err: err}
+	switch c.config.Errors.rule(err).Action {
+	case ActionAbort:
+		c.emit(failed)
+		c.emit(Event{
+			Type:    EventStopping,
+			Page:    pageNum,
+			Reason:  StopErrors,
+			Err:     err,
+			Message: fmt.Sprintf("Stopping crawling on %s error: %v", ClassifyError(err), err),
+		})
+		c.abortError = err
+		c.setStopCrawling(StopErrors)
+		return true
+	case ActionCount:
+		c.consecutiveErrors++
+		failed.Count = c.consecutiveErrors
+		c.emit(failed)
+
+		if c.consecutiveErrors >= c.config.Errors.MaxConsecutive {
+			c.emit(Event{
+				Type:    EventStopping,
+				Page:    pageNum,
+				Reason:  StopErrors,
+				Err:     err,
+				Message: fmt.Sprintf("Stopping crawling after %d consecutive errors", c.consecutiveErrors),
+			})
+			c.setStopCrawling(StopErrors)
+			return true
+		}
+	default:
+		// Přeskočené chyby řadu po sobě jdoucích chyb přeruší
+		c.consecutiveErrors = 0
+		c.emit(failed)
 	}
 
 	return false
 }
 
-// setStopCrawling sets the stop flag
-func (c *Crawler) setStopCrawling(stop bool) {
+// setStopCrawling sets the stop reason; the first reason wins until reset with StopNone
+func (c *Crawler) setStopCrawling(reason StopReason) {
 	c.stopMutex.Lock()
 	defer c.stopMutex.Unlock()
-	c.stopCrawling = stop
+	if reason == StopNone || c.stopReason == StopNone {
+		c.stopReason = reason
+	}
 }
 
 // shouldStopCrawling checks if crawling should stop
 func (c *Crawler) shouldStopCrawling() bool {
+	return c.currentStopReason() != StopNone
+}
+
+// currentStopReason returns why crawling was stopped (StopNone while running)
+func (c *Crawler) currentStopReason() StopReason {
 	c.stopMutex.Lock()
 	defer c.stopMutex.Unlock()
-	return c.stopCrawling
+	return c.stopReason
 }
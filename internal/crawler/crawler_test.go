@@ -0,0 +1,87 @@
+package crawler
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/database"
+)
+
+func TestCheckIncremental(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 7, d, 0, 0, 0, 0, time.UTC) }
+
+	// page vrátí torrenty t0..t(n-1) přidané postupně dřív a dřív (výpis data DESC)
+	page := func(n int) []Torrent {
+		torrents := make([]Torrent, n)
+		for i := range torrents {
+			torrents[i] = Torrent{ID: fmt.Sprintf("t%d", i), AddedDate: day(20 - i)}
+		}
+		return torrents
+	}
+	// knownOf označí torrenty se zadanými indexy jako známé se stejným datem
+	knownOf := func(torrents []Torrent, indexes ...int) map[string]database.KnownTorrent {
+		known := make(map[string]database.KnownTorrent)
+		for _, i := range indexes {
+			known[torrents[i].ID] = database.KnownTorrent{ID: torrents[i].ID, AddedDate: torrents[i].AddedDate}
+		}
+		return known
+	}
+
+	full := page(5)
+	noDate := page(3)
+	noDate[2].AddedDate = time.Time{}
+
+	tests := []struct {
+		name      string
+		torrents  []Torrent
+		known     map[string]database.KnownTorrent
+		threshold int
+		since     time.Time
+		wantStop  bool
+		wantKept  int
+	}{
+		{name: "prázdná stránka", torrents: nil, wantStop: true, wantKept: 0},
+		{name: "nic známého", torrents: full, known: nil, wantKept: 5},
+		{name: "celá stránka známá", torrents: full, known: knownOf(full, 0, 1, 2, 3, 4), wantStop: true, wantKept: 5},
+		{name: "část stránky známá bez prahu", torrents: full, known: knownOf(full, 1, 2, 3, 4), wantKept: 5},
+		{name: "práh po sobě jdoucích", torrents: full, known: knownOf(full, 2, 3, 4), threshold: 3, wantStop: true, wantKept: 5},
+		{name: "známé, ale ne po sobě", torrents: full, known: knownOf(full, 0, 2, 4), threshold: 2, wantKept: 5},
+		{name: "práh větší než stránka", torrents: full, known: knownOf(full, 0, 1, 2, 3, 4), threshold: 50, wantStop: true, wantKept: 5},
+		{
+			name:     "změněné datum přidání se nepočítá",
+			torrents: full,
+			known: map[string]database.KnownTorrent{
+				"t0": {ID: "t0", AddedDate: day(1)}, "t1": {ID: "t1", AddedDate: day(19)},
+				"t2": {ID: "t2", AddedDate: day(18)}, "t3": {ID: "t3", AddedDate: day(17)},
+				"t4": {ID: "t4", AddedDate: day(16)},
+			},
+			wantKept: 5,
+		},
+		{name: "since ořízne starší a zastaví", torrents: full, since: day(18), wantStop: true, wantKept: 3},
+		{name: "since před celou stránkou", torrents: full, since: day(1), wantKept: 5},
+		{name: "since za celou stránkou", torrents: full, since: day(25), wantStop: true, wantKept: 0},
+		{name: "torrent bez data since nezastaví", torrents: noDate, since: day(10), wantKept: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCrawler(Config{
+				Incremental:    true,
+				KnownThreshold: tt.threshold,
+				Since:          tt.since,
+				Observer:       ObserverFunc(func(Event) {}),
+			})
+
+			kept := c.checkIncremental(0, tt.torrents, tt.known)
+			if len(kept) != tt.wantKept {
+				t.Errorf("kept %d torrents, want %d", len(kept), tt.wantKept)
+			}
+			if stopped := c.shouldStopCrawling(); stopped != tt.wantStop {
+				t.Errorf("stopped = %v, want %v", stopped, tt.wantStop)
+			}
+			if tt.wantStop && c.stopReason != StopCaughtUp {
+				t.Errorf("stopReason = %v, want %v", c.stopReason, StopCaughtUp)
+			}
+		})
+	}
+}
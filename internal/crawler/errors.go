@@ -0,0 +1,166 @@
+package crawler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// HTTPStatusError vrací server, který neodpověděl 200 OK
+type HTTPStatusError struct {
+	StatusCode int
+	URL        string
+	RetryAfter time.Duration // z hlavičky Retry-After (0 = neuvedeno)
+}
+
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("%s returned status %d", e.URL, e.StatusCode)
+}
+
+// NetworkError je selhání spojení, timeout nebo chyba při čtení odpovědi
+type NetworkError struct {
+	URL string
+	Err error
+}
+
+func (e *NetworkError) Error() string {
+	return fmt.Sprintf("fetching %s: %v", e.URL, e.Err)
+}
+
+func (e *NetworkError) Unwrap() error { return e.Err }
+
+// ParseError znamená, že odpověď nešlo zpracovat jako HTML
+type ParseError struct {
+	URL string
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parsing %s: %v", e.URL, e.Err)
+}
+
+func (e *ParseError) Unwrap() error { return e.Err }
+
+// LayoutChangedError hlásí, že stránka se stáhla i zpracovala, ale její
+// struktura neodpovídá tomu, co parser očekává
+type LayoutChangedError struct {
+	URL    string
+	Reason string
+}
+
+func (e *LayoutChangedError) Error() string {
+	return fmt.Sprintf("layout of %s changed: %s", e.URL, e.Reason)
+}
+
+// ErrorClass je skupina chyb, pro kterou lze nastavit vlastní pravidlo
+type ErrorClass int
+
+const (
+	ErrorClassOther       ErrorClass = iota
+	ErrorClassHTTPClient             // 4xx kromě 429
+	ErrorClassHTTPServer             // 5xx
+	ErrorClassRateLimited            // 429 Too Many Requests
+	ErrorClassNetwork
+	ErrorClassParse
+	ErrorClassLayout
+)
+
+var errorClassNames = map[ErrorClass]string{
+	ErrorClassOther:       "other",
+	ErrorClassHTTPClient:  "http4xx",
+	ErrorClassHTTPServer:  "http5xx",
+	ErrorClassRateLimited: "ratelimit",
+	ErrorClassNetwork:     "network",
+	ErrorClassParse:       "parse",
+	ErrorClassLayout:      "layout",
+}
+
+func (c ErrorClass) String() string {
+	if name, ok := errorClassNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("ErrorClass(%d)", int(c))
+}
+
+// ParseErrorClass převede jméno třídy ("http4xx", "network", ...) na ErrorClass
+func ParseErrorClass(name string) (ErrorClass, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for class, className := range errorClassNames {
+		if className == name {
+			return class, nil
+		}
+	}
+	return ErrorClassOther, fmt.Errorf("unknown error class %q", name)
+}
+
+// ClassifyError zařadí chybu do třídy podle jejího typu
+func ClassifyError(err error) ErrorClass {
+	var statusErr *HTTPStatusError
+	var networkErr *NetworkError
+	var parseErr *ParseError
+	var layoutErr *LayoutChangedError
+
+	switch {
+	case errors.As(err, &statusErr):
+		switch {
+		case statusErr.StatusCode == http.StatusTooManyRequests:
+			return ErrorClassRateLimited
+		case statusErr.StatusCode >= 500:
+			return ErrorClassHTTPServer
+		case statusErr.StatusCode >= 400:
+			return ErrorClassHTTPClient
+		}
+	case errors.As(err, &networkErr):
+		return ErrorClassNetwork
+	case errors.As(err, &parseErr):
+		return ErrorClassParse
+	case errors.As(err, &layoutErr):
+		return ErrorClassLayout
+	}
+	return ErrorClassOther
+}
+
+// ErrorAction říká, co má crawler udělat se stránkou, která skončila chybou
+type ErrorAction int
+
+const (
+	ActionSkip  ErrorAction = iota // stránku přeskočit a pokračovat
+	ActionCount                    // pokračovat, ale počítat do limitu po sobě jdoucích chyb
+	ActionAbort                    // okamžitě ukončit crawling
+)
+
+// ErrorRule je pravidlo pro jednu třídu chyb
+type ErrorRule struct {
+	Action ErrorAction
+	Retry  bool // opakovat požadavek podle Config.Retry
+}
+
+// ErrorPolicy určuje pro každou třídu chyb, zda se opakuje a zda ukončí crawling
+type ErrorPolicy struct {
+	Rules          map[ErrorClass]ErrorRule
+	MaxConsecutive int // limit pro ActionCount
+}
+
+// DefaultErrorPolicy odpovídá původnímu chování: HTTP chyby se počítají
+// do limitu pěti po sobě jdoucích, ostatní chyby se přeskočí
+func DefaultErrorPolicy() ErrorPolicy {
+	return ErrorPolicy{
+		Rules: map[ErrorClass]ErrorRule{
+			ErrorClassHTTPClient:  {Action: ActionCount},
+			ErrorClassHTTPServer:  {Action: ActionCount, Retry: true},
+			ErrorClassRateLimited: {Action: ActionCount, Retry: true},
+			ErrorClassNetwork:     {Action: ActionSkip, Retry: true},
+			ErrorClassParse:       {Action: ActionSkip},
+			ErrorClassLayout:      {Action: ActionAbort},
+			ErrorClassOther:       {Action: ActionSkip},
+		},
+		MaxConsecutive: 5,
+	}
+}
+
+// rule vrátí pravidlo pro chybu; neuvedené třídy se přeskakují bez opakování
+func (p ErrorPolicy) rule(err error) ErrorRule {
+	return p.Rules[ClassifyError(err)]
+}
@@ -0,0 +1,116 @@
+package crawler
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want ErrorClass
+	}{
+		{&HTTPStatusError{StatusCode: 404}, ErrorClassHTTPClient},
+		{&HTTPStatusError{StatusCode: 403}, ErrorClassHTTPClient},
+		{&HTTPStatusError{StatusCode: 429}, ErrorClassRateLimited},
+		{&HTTPStatusError{StatusCode: 500}, ErrorClassHTTPServer},
+		{&HTTPStatusError{StatusCode: 503}, ErrorClassHTTPServer},
+		{&HTTPStatusError{StatusCode: 302}, ErrorClassOther},
+		{&NetworkError{Err: errors.New("connection reset")}, ErrorClassNetwork},
+		{&ParseError{Err: errors.New("bad html")}, ErrorClassParse},
+		{&LayoutChangedError{Reason: "no rows"}, ErrorClassLayout},
+		{fmt.Errorf("page 3: %w", &HTTPStatusError{StatusCode: 502}), ErrorClassHTTPServer},
+		{fmt.Errorf("page 3: %w", &LayoutChangedError{}), ErrorClassLayout},
+		{errors.New("something else"), ErrorClassOther},
+	}
+	for _, tt := range tests {
+		if got := ClassifyError(tt.err); got != tt.want {
+			t.Errorf("ClassifyError(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestParseErrorClass(t *testing.T) {
+	for class, name := range errorClassNames {
+		got, err := ParseErrorClass(" " + name + " ")
+		if err != nil || got != class {
+			t.Errorf("ParseErrorClass(%q) = %v, %v; want %v", name, got, err, class)
+		}
+	}
+	if got, err := ParseErrorClass("HTTP5XX"); err != nil || got != ErrorClassHTTPServer {
+		t.Errorf("ParseErrorClass(HTTP5XX) = %v, %v", got, err)
+	}
+	if _, err := ParseErrorClass("timeout"); err == nil {
+		t.Error("ParseErrorClass(timeout): expected error")
+	}
+}
+
+func TestErrorPolicyRule(t *testing.T) {
+	policy := DefaultErrorPolicy()
+
+	tests := []struct {
+		err  error
+		want ErrorRule
+	}{
+		{&HTTPStatusError{StatusCode: 404}, ErrorRule{Action: ActionCount}},
+		{&HTTPStatusError{StatusCode: 500}, ErrorRule{Action: ActionCount, Retry: true}},
+		{&HTTPStatusError{StatusCode: 429}, ErrorRule{Action: ActionCount, Retry: true}},
+		{&NetworkError{}, ErrorRule{Action: ActionSkip, Retry: true}},
+		{&ParseError{}, ErrorRule{Action: ActionSkip}},
+		{&LayoutChangedError{}, ErrorRule{Action: ActionAbort}},
+	}
+	for _, tt := range tests {
+		if got := policy.rule(tt.err); got != tt.want {
+			t.Errorf("rule(%v) = %+v, want %+v", tt.err, got, tt.want)
+		}
+	}
+
+	// Třída bez pravidla se přeskočí bez opakování
+	empty := ErrorPolicy{Rules: map[ErrorClass]ErrorRule{}}
+	if got := empty.rule(&HTTPStatusError{StatusCode: 500}); got != (ErrorRule{}) {
+		t.Errorf("rule without entry = %+v, want zero rule", got)
+	}
+}
+
+func TestRecordError(t *testing.T) {
+	notFound := &HTTPStatusError{StatusCode: 404}
+	parseErr := &ParseError{Err: errors.New("bad html")}
+	layoutErr := &LayoutChangedError{Reason: "no rows"}
+
+	tests := []struct {
+		name     string
+		errs     []error // výsledky stránek v pořadí, nil = úspěch
+		wantStop int     // index výsledku, na kterém se má crawling zastavit (-1 = vůbec)
+	}{
+		{"počítané chyby do limitu", []error{notFound, notFound, notFound}, 2},
+		{"úspěch vynuluje počítadlo", []error{notFound, notFound, nil, notFound, notFound}, -1},
+		{"přeskočená chyba vynuluje počítadlo", []error{notFound, notFound, parseErr, notFound, notFound}, -1},
+		{"přeskočené chyby se nepočítají", []error{parseErr, parseErr, parseErr, parseErr}, -1},
+		{"abort ukončí hned", []error{nil, layoutErr}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := DefaultErrorPolicy()
+			policy.MaxConsecutive = 3
+			c := NewCrawler(Config{Errors: policy, Observer: ObserverFunc(func(Event) {})})
+
+			stoppedAt := -1
+			for i, err := range tt.errs {
+				if c.recordError(i, err) {
+					stoppedAt = i
+					break
+				}
+			}
+			if stoppedAt != tt.wantStop {
+				t.Fatalf("stopped at %d, want %d", stoppedAt, tt.wantStop)
+			}
+			if stoppedAt >= 0 && c.stopReason != StopErrors {
+				t.Errorf("stopReason = %v, want %v", c.stopReason, StopErrors)
+			}
+			if tt.errs[len(tt.errs)-1] == layoutErr && c.abortError != layoutErr {
+				t.Errorf("abortError = %v, want %v", c.abortError, layoutErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package crawler
+
+import (
+	"sync"
+	"time"
+)
+
+// EventType určuje druh události, kterou crawler hlásí pozorovatelům
+type EventType string
+
+const (
+	EventRunStarted     EventType = "run_started"     // Count = počet stránek ke zpracování
+	EventPageStarted    EventType = "page_started"    // worker začal stahovat stránku
+	EventPageParsed     EventType = "page_parsed"     // Count = počet torrentů na stránce
+	EventPageSaved      EventType = "page_saved"      // Count = počet uložených torrentů
+	EventPageFailed     EventType = "page_failed"     // Err, Count = počet chyb po sobě
+	EventPageUnchanged  EventType = "page_unchanged"  // stránka beze změny od minula, Count = torrenty při minulém uložení
+	EventTorrentNew     EventType = "torrent_new"     // Torrent, který v databázi ještě nebyl
+	EventTorrentUpdated EventType = "torrent_updated" // Torrent, který už v databázi byl
+	EventDetailFetched  EventType = "detail_fetched"  // Torrent se staženým Detail
+	EventRetry          EventType = "retry"           // URL, Err, Attempt/MaxAttempts, Delay
+	EventConcurrency    EventType = "concurrency"     // Count = nová souběžnost, Message = důvod, Err = chyba, která ji snížila
+	EventError          EventType = "error"           // chyba, která nezastaví stránku (např. zápis do DB)
+	EventNotice         EventType = "notice"          // informativní zpráva (robots.txt, ...)
+	EventStopping       EventType = "stopping"        // Reason, proč crawling končí dřív
+	EventRunFinished    EventType = "run_finished"    // Summary
+)
+
+// NoPage je Event.Page u událostí, které se netýkají jedné stránky výpisu
+const NoPage = -1
+
+// Event je jedna událost crawleru. Vyplněná jsou jen pole, která k danému
+// typu patří (viz EventType).
+type Event struct {
+	Type        EventType
+	Time        time.Time
+	RunID       int64
+	Workers     int // souběžnost v okamžiku události (0 = mimo běh)
+	Page        int
+	URL         string
+	Torrent     *Torrent
+	Count       int
+	Resumed     bool // EventRunStarted: navázání na dřívější běh
+	Attempt     int  // EventRetry: číslo následujícího pokusu
+	MaxAttempts int
+	Delay       time.Duration
+	Err         error
+	Reason      StopReason
+	Message     string
+	Summary     *Summary
+}
+
+// Observer dostává události crawleru. Crawler volá Observe postupně (nikdy
+// souběžně), pomalý pozorovatel ale zdržuje workery.
+type Observer interface {
+	Observe(Event)
+}
+
+// ObserverFunc umožní použít obyčejnou funkci jako Observer
+type ObserverFunc func(Event)
+
+func (f ObserverFunc) Observe(e Event) { f(e) }
+
+// MultiObserver rozešle každou událost všem pozorovatelům v daném pořadí
+func MultiObserver(observers ...Observer) Observer {
+	return multiObserver(observers)
+}
+
+type multiObserver []Observer
+
+func (m multiObserver) Observe(e Event) {
+	for _, o := range m {
+		if o != nil {
+			o.Observe(e)
+		}
+	}
+}
+
+// eventBus serializuje události z workerů pro Config.Observer
+type eventBus struct {
+	mu       sync.Mutex
+	observer Observer
+}
+
+// emit doplní čas a běh a předá událost pozorovateli
+func (c *Crawler) emit(e Event) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	if e.RunID == 0 {
+		e.RunID = c.runID
+	}
+	if e.Workers == 0 && c.concurrency != nil {
+		e.Workers = c.concurrency.current()
+	}
+
+	c.events.mu.Lock()
+	defer c.events.mu.Unlock()
+	if c.events.observer != nil {
+		c.events.observer.Observe(e)
+	}
+}
+
+// emitError ohlásí chybu, která neukončí zpracování stránky
+func (c *Crawler) emitError(page int, message string, err error) {
+	c.emit(Event{Type: EventError, Page: page, Message: message, Err: err})
+}
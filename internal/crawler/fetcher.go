@@ -0,0 +1,180 @@
+package crawler
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/archive"
+)
+
+// Fetcher provádí HTTP požadavky crawleru. Díky němu lze živé stahování
+// nahradit nahráváním odpovědí na disk nebo jejich přehráváním offline.
+type Fetcher interface {
+	Fetch(req *http.Request) (*http.Response, error)
+}
+
+// HTTPFetcher stahuje stránky živě přes http.Client
+type HTTPFetcher struct {
+	client *http.Client
+}
+
+func NewHTTPFetcher(timeout time.Duration) *HTTPFetcher {
+	return &HTTPFetcher{
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+func (f *HTTPFetcher) Fetch(req *http.Request) (*http.Response, error) {
+	return f.client.Do(req)
+}
+
+// recordedURLHeader nese v uložené odpovědi původní URL (pro čitelnost kazet)
+const recordedURLHeader = "X-Recorded-Url"
+
+// RecordFetcher předává požadavky dalšímu Fetcheru a každou odpověď uloží
+// do adresáře jako kazetu pojmenovanou podle hashe URL
+type RecordFetcher struct {
+	next Fetcher
+	dir  string
+}
+
+func NewRecordFetcher(next Fetcher, dir string) (*RecordFetcher, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating record directory: %w", err)
+	}
+	return &RecordFetcher{next: next, dir: dir}, nil
+}
+
+func (f *RecordFetcher) Fetch(req *http.Request) (*http.Response, error) {
+	resp, err := f.next.Fetch(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+
+	// Tělo už je přečtené, uložíme ho s pevnou délkou bez transfer-encodingu
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.TransferEncoding = nil
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Set(recordedURLHeader, req.URL.String())
+
+	var buf bytes.Buffer
+	if err := resp.Write(&buf); err != nil {
+		return nil, fmt.Errorf("serializing response: %w", err)
+	}
+	if err := writeFileAtomic(cassettePath(f.dir, req.URL.String()), buf.Bytes()); err != nil {
+		return nil, fmt.Errorf("recording %s: %w", req.URL, err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// ArchiveFetcher předává požadavky dalšímu Fetcheru a těla úspěšných
+// odpovědí výpisu a detailů ukládá do archivu (pro cmd/reparse). Na rozdíl
+// od RecordFetcheru si pamatuje každou změněnou verzi stránky. Chyba
+// archivu crawling nezastaví, odpověď se vrátí i tak.
+type ArchiveFetcher struct {
+	next    Fetcher
+	archive *archive.Archive
+
+	// OnStoreError se zavolá, když se stránku nepodařilo archivovat (může
+	// být voláno z více workerů současně); nil chybu zahodí
+	OnStoreError func(url string, err error)
+}
+
+func NewArchiveFetcher(next Fetcher, a *archive.Archive) *ArchiveFetcher {
+	return &ArchiveFetcher{next: next, archive: a}
+}
+
+func (f *ArchiveFetcher) Fetch(req *http.Request) (*http.Response, error) {
+	resp, err := f.next.Fetch(req)
+	if err != nil || resp.StatusCode != http.StatusOK || archive.KindOf(req.URL.String()) == "" {
+		return resp, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+	if _, err := f.archive.Store(req.Context(), req.URL.String(), time.Now(), body); err != nil && f.OnStoreError != nil {
+		f.OnStoreError(req.URL.String(), err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// ReplayFetcher obsluhuje požadavky výhradně z kazet uložených RecordFetcherem
+type ReplayFetcher struct {
+	dir string
+}
+
+func NewReplayFetcher(dir string) (*ReplayFetcher, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("opening replay directory: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("replay path %s is not a directory", dir)
+	}
+	return &ReplayFetcher{dir: dir}, nil
+}
+
+func (f *ReplayFetcher) Fetch(req *http.Request) (*http.Response, error) {
+	data, err := os.ReadFile(cassettePath(f.dir, req.URL.String()))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no recorded response for %s", req.URL)
+		}
+		return nil, fmt.Errorf("reading recorded response: %w", err)
+	}
+
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(data)), req)
+	if err != nil {
+		return nil, fmt.Errorf("parsing recorded response for %s: %w", req.URL, err)
+	}
+	return resp, nil
+}
+
+// cassettePath vrátí cestu ke kazetě pro danou URL
+func cassettePath(dir, rawURL string) string {
+	sum := sha256.Sum256([]byte(rawURL))
+	return filepath.Join(dir, hex.EncodeToString(sum[:])+".http")
+}
+
+// writeFileAtomic zapíše soubor přes dočasný soubor, aby souběžné workery
+// nikdy neviděly napůl zapsanou kazetu
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
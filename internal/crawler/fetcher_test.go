@@ -0,0 +1,101 @@
+package crawler
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// fetcherFunc umožní použít obyčejnou funkci jako Fetcher
+type fetcherFunc func(req *http.Request) (*http.Response, error)
+
+func (f fetcherFunc) Fetch(req *http.Request) (*http.Response, error) { return f(req) }
+
+// textResponse vrátí odpověď s daným stavem a tělem
+func textResponse(req *http.Request, status int, body string, header http.Header) *http.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		StatusCode:    status,
+		Status:        http.StatusText(status),
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func TestRecordReplayRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	pages := map[string]string{
+		"https://sktorrent.eu/torrent/torrents_v2.php?page=0": "<html>výpis 0</html>",
+		"https://sktorrent.eu/torrent/torrents_v2.php?page=1": "<html>výpis 1</html>",
+	}
+	live := fetcherFunc(func(req *http.Request) (*http.Response, error) {
+		header := http.Header{"Etag": []string{`"abc"`}}
+		return textResponse(req, http.StatusOK, pages[req.URL.String()], header), nil
+	})
+
+	recorder, err := NewRecordFetcher(live, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for url, want := range pages {
+		if got := fetchBody(t, recorder, url); got != want {
+			t.Errorf("recorded %s = %q, want %q", url, got, want)
+		}
+	}
+
+	replay, err := NewReplayFetcher(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for url, want := range pages {
+		req, _ := http.NewRequest(http.MethodGet, url, nil)
+		resp, err := replay.Fetch(req)
+		if err != nil {
+			t.Fatalf("replay %s: %v", url, err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if string(body) != want {
+			t.Errorf("replayed %s = %q, want %q", url, body, want)
+		}
+		if resp.StatusCode != http.StatusOK || resp.Header.Get("ETag") != `"abc"` {
+			t.Errorf("replayed %s: status %d, ETag %q", url, resp.StatusCode, resp.Header.Get("ETag"))
+		}
+		if got := resp.Header.Get(recordedURLHeader); got != url {
+			t.Errorf("replayed %s: %s = %q", url, recordedURLHeader, got)
+		}
+	}
+
+	// Nenahraná stránka je chyba, ne prázdná odpověď
+	req, _ := http.NewRequest(http.MethodGet, "https://sktorrent.eu/torrent/torrents_v2.php?page=2", nil)
+	if _, err := replay.Fetch(req); err == nil {
+		t.Error("replay of an unrecorded URL succeeded")
+	}
+}
+
+func fetchBody(t *testing.T, f Fetcher, url string) string {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := f.Fetch(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(resp.Body); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
@@ -0,0 +1,142 @@
+package crawler
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// JSONObserver zapisuje každou událost jako jeden řádek JSON (pro logy
+// z cronu a další zpracování)
+type JSONObserver struct {
+	enc *json.Encoder
+}
+
+func NewJSONObserver(w io.Writer) *JSONObserver {
+	return &JSONObserver{enc: json.NewEncoder(w)}
+}
+
+type jsonEvent struct {
+	Time        time.Time    `json:"time"`
+	Type        EventType    `json:"type"`
+	RunID       int64        `json:"run_id,omitempty"`
+	Workers     int          `json:"workers,omitempty"`
+	Page        *int         `json:"page,omitempty"`
+	URL         string       `json:"url,omitempty"`
+	Torrent     *jsonTorrent `json:"torrent,omitempty"`
+	Count       *int         `json:"count,omitempty"`
+	Resumed     bool         `json:"resumed,omitempty"`
+	Attempt     int          `json:"attempt,omitempty"`
+	MaxAttempts int          `json:"max_attempts,omitempty"`
+	DelayMS     int64        `json:"delay_ms,omitempty"`
+	Error       string       `json:"error,omitempty"`
+	ErrorClass  string       `json:"error_class,omitempty"`
+	Reason      string       `json:"reason,omitempty"`
+	Message     string       `json:"message,omitempty"`
+	Summary     *jsonSummary `json:"summary,omitempty"`
+}
+
+type jsonTorrent struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Category   string     `json:"category,omitempty"`
+	SizeMB     float64    `json:"size_mb"`
+	Seeds      int        `json:"seeds"`
+	Leeches    int        `json:"leeches"`
+	CSFDRating int        `json:"csfd_rating,omitempty"`
+	AddedDate  *time.Time `json:"added_date,omitempty"` // nil = datum se nepodařilo přečíst
+}
+
+type jsonSummary struct {
+	TotalTorrents  int    `json:"total_torrents"`
+	SavedTorrents  int    `json:"saved_torrents"`
+	NewTorrents    int    `json:"new_torrents"`
+	ErrorPages     int    `json:"error_pages"`
+	Retries        int    `json:"retries"`
+	DetailsFetched int    `json:"details_fetched"`
+	DetailsSkipped int    `json:"details_skipped"`
+	Workers        int    `json:"workers"`
+	PeakWorkers    int    `json:"peak_workers"`
+	FinalWorkers   int    `json:"final_workers"`
+	Backoffs       int    `json:"backoffs"`
+	UnchangedPages int    `json:"unchanged_pages"`
+	ListingPages   int    `json:"listing_pages,omitempty"`
+	StopReason     string `json:"stop_reason"`
+	AbortError     string `json:"abort_error,omitempty"`
+	LayoutErrors   int    `json:"layout_errors"`
+	LayoutError    string `json:"layout_error,omitempty"`
+}
+
+func (o *JSONObserver) Observe(e Event) {
+	out := jsonEvent{
+		Time:        e.Time,
+		Type:        e.Type,
+		RunID:       e.RunID,
+		Workers:     e.Workers,
+		URL:         e.URL,
+		Resumed:     e.Resumed,
+		Attempt:     e.Attempt,
+		MaxAttempts: e.MaxAttempts,
+		DelayMS:     e.Delay.Milliseconds(),
+		Message:     e.Message,
+	}
+	if e.Page != NoPage {
+		page := e.Page
+		out.Page = &page
+	}
+	switch e.Type {
+	case EventRunStarted, EventPageParsed, EventPageSaved, EventPageUnchanged, EventPageFailed:
+		count := e.Count
+		out.Count = &count
+	}
+	if e.Err != nil {
+		out.Error = e.Err.Error()
+		out.ErrorClass = ClassifyError(e.Err).String()
+	}
+	if e.Type == EventStopping {
+		out.Reason = e.Reason.String()
+	}
+	if t := e.Torrent; t != nil {
+		out.Torrent = &jsonTorrent{
+			ID:         t.ID,
+			Name:       t.Name,
+			Category:   t.Category,
+			SizeMB:     t.SizeMB,
+			Seeds:      t.Seeds,
+			Leeches:    t.Leeches,
+			CSFDRating: t.CSFDRating,
+		}
+		if !t.AddedDate.IsZero() {
+			added := t.AddedDate
+			out.Torrent.AddedDate = &added
+		}
+	}
+	if s := e.Summary; s != nil {
+		out.Summary = &jsonSummary{
+			TotalTorrents:  s.TotalTorrents,
+			SavedTorrents:  s.SavedTorrents,
+			NewTorrents:    s.NewTorrents,
+			ErrorPages:     s.ErrorPages,
+			Retries:        s.Retries,
+			DetailsFetched: s.DetailsFetched,
+			DetailsSkipped: s.DetailsSkipped,
+			Workers:        s.Workers,
+			PeakWorkers:    s.PeakWorkers,
+			FinalWorkers:   s.FinalWorkers,
+			Backoffs:       s.Backoffs,
+			UnchangedPages: s.UnchangedPages,
+			ListingPages:   s.ListingPages,
+			StopReason:     s.StopReason.String(),
+			LayoutErrors:   s.LayoutErrors,
+		}
+		if s.AbortError != nil {
+			out.Summary.AbortError = s.AbortError.Error()
+		}
+		if s.LayoutError != nil {
+			out.Summary.LayoutError = s.LayoutError.Error()
+		}
+	}
+
+	// Chyba zápisu logu nesmí shodit crawling
+	_ = o.enc.Encode(out)
+}
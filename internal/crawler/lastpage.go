@@ -0,0 +1,157 @@
+package crawler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/parser"
+)
+
+// maxListingPages je pojistka hledání konce výpisu pro server, který
+// odpovídá neprázdnou stránkou na jakékoli číslo
+const maxListingPages = 1 << 20
+
+// LastPage je výsledek FindLastPage
+type LastPage struct {
+	Page      int  // poslední neprázdná stránka (-1 = výpis je prázdný)
+	Probes    int  // kolik stránek se kvůli hledání stáhlo
+	FromLinks bool // konec určily odkazy stránkování bez půlení intervalu
+}
+
+// Pages vrátí počet stránek výpisu
+func (l LastPage) Pages() int {
+	return l.Page + 1
+}
+
+// FindLastPage najde poslední neprázdnou stránku výpisu. Nejdřív přeskakuje
+// po nejvyšších odkazech stránkování, dokud přibývají; kde odkazy chybí
+// nebo vedou za konec, dohledá konec zdvojováním kroku a půlením intervalu.
+// Za koncem je buď prázdná stránka, nebo (u některých výpisů) znovu ta
+// poslední - tu pozná podle stejného prvního torrentu pod dvěma čísly.
+func (c *Crawler) FindLastPage(ctx context.Context) (LastPage, error) {
+	var result LastPage
+	seen := make(map[string]int) // první torrent neprázdné stránky -> číslo stránky
+	repeated := ""               // první torrent stránky, kterou výpis opakuje za koncem
+	// lo je poslední známá stránka před koncem, hi první známá stránka za
+	// koncem (-1 = zatím žádná); hiRepeat = hi je opakovaná poslední stránka
+	lo, hi := -1, -1
+	hiRepeat := false
+
+	setHi := func(pageNum int, repeat bool) {
+		if hi == -1 || pageNum < hi {
+			hi, hiRepeat = pageNum, repeat
+		}
+	}
+
+	// visit stáhne stránku, zařadí ji před nebo za konec výpisu a vrátí
+	// nejvyšší stránku z jejích odkazů stránkování
+	visit := func(pageNum int) (int, error) {
+		result.Probes++
+		url := c.pageURL(pageNum)
+		body, err := c.get(ctx, url)
+		if err != nil {
+			return 0, fmt.Errorf("page %d: %w", pageNum, err)
+		}
+		page, err := parser.ParseListingPage(bytes.NewReader(body), time.Now())
+		if err != nil {
+			return 0, fmt.Errorf("page %d: %w", pageNum, &ParseError{URL: url, Err: err})
+		}
+
+		if len(page.Listings) == 0 {
+			setHi(pageNum, false)
+			return page.LastPageLink, nil
+		}
+		id := page.Listings[0].ID
+		if other, ok := seen[id]; ok && other != pageNum {
+			// Stejná stránka pod dvěma čísly: obě leží na konci nebo za ním,
+			// hranicí je nejnižší stránka s tímto obsahem
+			repeated = id
+			setHi(min(other, pageNum), true)
+			lo = -1
+			for otherID, p := range seen {
+				if otherID != id && p < hi && p > lo {
+					lo = p
+				}
+			}
+			return page.LastPageLink, nil
+		}
+		if id == repeated {
+			setHi(pageNum, true)
+			return page.LastPageLink, nil
+		}
+		seen[id] = pageNum
+		lo = max(lo, pageNum)
+		return page.LastPageLink, nil
+	}
+
+	link, err := visit(0)
+	if err != nil {
+		return result, err
+	}
+	if hi == 0 && !hiRepeat {
+		result.Page = -1
+		return result, nil
+	}
+
+	// Odkazy stránkování
+	for hi == -1 && link > lo {
+		if link, err = visit(link); err != nil {
+			return result, err
+		}
+	}
+	if hi == -1 && lo > 0 {
+		// Nejvyšší odkaz dál neodkazuje; ověříme, že za ním nic není
+		if _, err := visit(lo + 1); err != nil {
+			return result, err
+		}
+		result.FromLinks = hi != -1 && hi-lo <= 1
+	}
+
+	// Zdvojování kroku, dokud nenarazíme na stránku za koncem
+	for step := 1; hi == -1; step *= 2 {
+		next := lo + step
+		if next >= maxListingPages {
+			return result, fmt.Errorf("no page past the end found up to page %d", maxListingPages)
+		}
+		if _, err := visit(next); err != nil {
+			return result, err
+		}
+	}
+
+	// Půlení intervalu (lo, hi)
+	for hi-lo > 1 {
+		if _, err := visit(lo + (hi-lo)/2); err != nil {
+			return result, err
+		}
+	}
+
+	result.Page = lo
+	if hiRepeat {
+		result.Page = hi
+	}
+	return result, nil
+}
+
+// CrawlAll najde konec výpisu (FindLastPage) a projde stránky from až po
+// poslední. Počet nalezených stránek je v Summary.ListingPages.
+func (c *Crawler) CrawlAll(ctx context.Context, from int) (Summary, error) {
+	last, err := c.FindLastPage(ctx)
+	if err != nil {
+		return Summary{}, fmt.Errorf("finding last page: %w", err)
+	}
+	how := "půlením intervalu"
+	if last.FromLinks {
+		how = "podle stránkování"
+	}
+	c.emit(Event{Type: EventNotice, Page: NoPage, Count: last.Pages(),
+		Message: fmt.Sprintf("📚 Výpis má %d stránek (poslední %d, zjištěno %s, %d požadavků)", last.Pages(), last.Page, how, last.Probes)})
+	if from > last.Page {
+		return Summary{}, fmt.Errorf("listing has %d pages, page %d is past the end", last.Pages(), from)
+	}
+
+	c.listingPages = last.Pages()
+	defer func() { c.listingPages = 0 }()
+	return c.Crawl(ctx, from, last.Page), nil
+}
@@ -0,0 +1,132 @@
+package crawler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// listingSite je falešný výpis se stránkami 0..last (last < 0 = prázdný výpis)
+type listingSite struct {
+	last     int
+	links    func(page int) int // nejvyšší odkaz stránkování na stránce (0 = bez odkazů)
+	repeat   bool               // za koncem vrací znovu poslední stránku místo prázdné
+	infinite bool               // neprázdná stránka pro jakékoli číslo
+}
+
+func (s listingSite) Fetch(req *http.Request) (*http.Response, error) {
+	page, err := strconv.Atoi(req.URL.Query().Get("page"))
+	if err != nil {
+		return textResponse(req, http.StatusBadRequest, "", nil), nil
+	}
+
+	content := page
+	switch {
+	case s.infinite:
+	case page > s.last && s.repeat && s.last >= 0:
+		content = s.last
+	case page > s.last:
+		content = -1
+	}
+
+	var b strings.Builder
+	b.WriteString(`<html><body><table class="lista"><tr>`)
+	if content >= 0 {
+		for i := range 3 {
+			fmt.Fprintf(&b, `<td class="lista"><a href="details.php?name=t&amp;id=%040x"><b>Torrent %d-%d</b></a></td>`, content*10+i+1, content, i)
+		}
+	}
+	b.WriteString(`</tr></table>`)
+	if s.links != nil {
+		if link := s.links(page); link > 0 {
+			fmt.Fprintf(&b, `<a href="torrents_v2.php?active=0&amp;page=%d">%d</a>`, link, link+1)
+		}
+	}
+	b.WriteString(`</body></html>`)
+	return textResponse(req, http.StatusOK, b.String(), nil), nil
+}
+
+func TestFindLastPage(t *testing.T) {
+	tests := []struct {
+		name          string
+		site          listingSite
+		want          int
+		wantFromLinks bool
+		maxProbes     int
+		wantErr       string
+	}{
+		{name: "prázdný výpis", site: listingSite{last: -1}, want: -1, maxProbes: 1},
+		{name: "jen první stránka", site: listingSite{last: 0}, want: 0, maxProbes: 2},
+		{
+			name: "poslední stránka v odkazech",
+			site: listingSite{last: 1234, links: func(int) int { return 1234 }},
+			want: 1234, wantFromLinks: true, maxProbes: 3,
+		},
+		{
+			// Odkazy jen na pár stránek dopředu: přeskakuje po nich až na konec
+			name: "odkazy o deset stránek dál",
+			site: listingSite{last: 57, links: func(p int) int { return min(p+10, 57) }},
+			want: 57, wantFromLinks: true, maxProbes: 8,
+		},
+		{
+			// Odkaz vede za konec výpisu (počet stránek z doby před smazáním)
+			name: "odkaz za konec výpisu",
+			site: listingSite{last: 90, links: func(int) int { return 100 }},
+			want: 90, maxProbes: 12,
+		},
+		{name: "bez odkazů", site: listingSite{last: 777}, want: 777, maxProbes: 25},
+		{name: "bez odkazů, mocnina dvou", site: listingSite{last: 512}, want: 512, maxProbes: 25},
+		{name: "za koncem opakuje poslední stránku", site: listingSite{last: 300, repeat: true}, want: 300, maxProbes: 25},
+		{
+			name: "opakuje poslední stránku a odkazuje na ni",
+			site: listingSite{last: 41, repeat: true, links: func(int) int { return 41 }},
+			want: 41, maxProbes: 12,
+		},
+		{name: "opakuje jedinou stránku", site: listingSite{last: 0, repeat: true}, want: 0, maxProbes: 3},
+		{
+			name:    "pojistka maxListingPages",
+			site:    listingSite{infinite: true},
+			wantErr: fmt.Sprintf("no page past the end found up to page %d", maxListingPages),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCrawler(Config{Fetcher: tt.site, Observer: ObserverFunc(func(Event) {})})
+			got, err := c.FindLastPage(context.Background())
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("FindLastPage error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Page != tt.want {
+				t.Errorf("Page = %d, want %d", got.Page, tt.want)
+			}
+			if got.Pages() != tt.want+1 {
+				t.Errorf("Pages() = %d, want %d", got.Pages(), tt.want+1)
+			}
+			if got.FromLinks != tt.wantFromLinks {
+				t.Errorf("FromLinks = %v, want %v", got.FromLinks, tt.wantFromLinks)
+			}
+			if got.Probes > tt.maxProbes {
+				t.Errorf("Probes = %d, want at most %d", got.Probes, tt.maxProbes)
+			}
+		})
+	}
+}
+
+func TestFindLastPageFetchError(t *testing.T) {
+	fetcher := fetcherFunc(func(req *http.Request) (*http.Response, error) {
+		return textResponse(req, http.StatusNotFound, "", nil), nil
+	})
+	c := NewCrawler(Config{Fetcher: fetcher, Observer: ObserverFunc(func(Event) {}), Retry: RetryPolicy{MaxAttempts: 1}})
+	if _, err := c.FindLastPage(context.Background()); err == nil || !strings.Contains(err.Error(), "page 0") {
+		t.Errorf("FindLastPage error = %v, want page 0 error", err)
+	}
+}
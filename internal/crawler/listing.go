@@ -0,0 +1,177 @@
+package crawler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/config"
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/parser"
+	"github.com/PuerkitoBio/goquery"
+)
+
+// ListingOrder je hodnota parametru order ve výpisu
+type ListingOrder string
+
+const (
+	OrderDate    ListingOrder = "data"
+	OrderName    ListingOrder = "name"
+	OrderSize    ListingOrder = "size"
+	OrderSeeds   ListingOrder = "seeders"
+	OrderLeeches ListingOrder = "leechers"
+)
+
+// listingOrderNames převádí jména z příkazové řádky na hodnoty parametru
+var listingOrderNames = map[string]ListingOrder{
+	"date":    OrderDate,
+	"name":    OrderName,
+	"size":    OrderSize,
+	"seeds":   OrderSeeds,
+	"leeches": OrderLeeches,
+}
+
+// ParseListingOrder převede "date", "name", "size", "seeds" nebo "leeches" na ListingOrder
+func ParseListingOrder(name string) (ListingOrder, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if order, ok := listingOrderNames[name]; ok {
+		return order, nil
+	}
+	return "", fmt.Errorf("unknown listing order %q", name)
+}
+
+// ListingQuery popisuje výpis torrentů (torrents_v2.php) a skládá jeho URL.
+// Nulová hodnota je celý výpis seřazený od nejnovějších.
+type ListingQuery struct {
+	BaseURL    string       // adresa výpisu (default: config.DefaultBaseURL); vlastní parametry přepíšou stejnojmenné v ní
+	Category   int          // category=N, 0 = všechny kategorie
+	Genre      string       // zaner
+	Language   string       // jazyk
+	Order      ListingOrder // order (default: OrderDate)
+	Ascending  bool         // by=ASC místo by=DESC
+	ActiveOnly bool         // active=1: jen torrenty, které někdo seeduje
+}
+
+// URL vrátí adresu dané stránky výpisu. Parametry, které už BaseURL
+// obsahuje (starší konfigurace s "?active=0&...&page=0"), se nahradí, aby
+// se v adrese neopakovaly.
+func (q ListingQuery) URL(page int) string {
+	base := q.BaseURL
+	if base == "" {
+		base = config.DefaultBaseURL
+	}
+
+	order := q.Order
+	if order == "" {
+		order = OrderDate
+	}
+	by := "DESC"
+	if q.Ascending {
+		by = "ASC"
+	}
+	active := "0"
+	if q.ActiveOnly {
+		active = "1"
+	}
+
+	u, err := url.Parse(base)
+	if err != nil {
+		// Neplatnou adresu odmítne už config.Validate
+		return base
+	}
+	params := u.Query()
+	params.Set("active", active)
+	params.Set("order", string(order))
+	params.Set("by", by)
+	params.Set("zaner", q.Genre)
+	params.Set("jazyk", q.Language)
+	if q.Category != 0 {
+		params.Set("category", strconv.Itoa(q.Category))
+	}
+	params.Set("page", strconv.Itoa(page))
+
+	u.RawQuery = params.Encode()
+	return u.String()
+}
+
+// String popíše výpis pro výstup na konzoli
+func (q ListingQuery) String() string {
+	var parts []string
+	if q.Category != 0 {
+		parts = append(parts, fmt.Sprintf("kategorie %d", q.Category))
+	}
+	if q.Genre != "" {
+		parts = append(parts, "žánr "+q.Genre)
+	}
+	if q.Language != "" {
+		parts = append(parts, "jazyk "+q.Language)
+	}
+	if q.Order != "" && q.Order != OrderDate {
+		parts = append(parts, "řazení "+string(q.Order))
+	}
+	if q.Ascending {
+		parts = append(parts, "vzestupně")
+	}
+	if q.ActiveOnly {
+		parts = append(parts, "jen aktivní")
+	}
+	if len(parts) == 0 {
+		return "celý výpis"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ResolveCategory najde číslo kategorie podle jejího názvu ("Seriál") v odkazech
+// na první stránce výpisu. Názvy se porovnávají bez diakritiky a velikosti písmen.
+func (c *Crawler) ResolveCategory(ctx context.Context, name string) (int, error) {
+	pageURL := ListingQuery{BaseURL: c.config.Listing.BaseURL}.URL(0)
+	body, err := c.get(ctx, pageURL)
+	if err != nil {
+		return 0, fmt.Errorf("fetching listing: %w", err)
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return 0, &ParseError{URL: pageURL, Err: err}
+	}
+
+	want := parser.NormalizeLabel(name)
+	var seen []string
+	category := 0
+	doc.Find("a[href*='category=']").EachWithBreak(func(i int, s *goquery.Selection) bool {
+		label := strings.TrimSpace(s.Text())
+		href, _ := s.Attr("href")
+		u, err := url.Parse(href)
+		if err != nil || label == "" {
+			return true
+		}
+		id, err := strconv.Atoi(u.Query().Get("category"))
+		if err != nil {
+			return true
+		}
+		if parser.NormalizeLabel(label) == want {
+			category = id
+			return false
+		}
+		seen = append(seen, label)
+		return true
+	})
+
+	if category == 0 {
+		return 0, fmt.Errorf("category %q not found on listing (known: %s)", name, strings.Join(uniqueStrings(seen), ", "))
+	}
+	return category, nil
+}
+
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool)
+	var unique []string
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			unique = append(unique, v)
+		}
+	}
+	return unique
+}
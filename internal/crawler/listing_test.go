@@ -0,0 +1,116 @@
+package crawler
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestListingQueryURL(t *testing.T) {
+	const base = "https://sktorrent.eu/torrent/torrents_v2.php"
+
+	tests := []struct {
+		name  string
+		query ListingQuery
+		page  int
+		want  string
+	}{
+		{
+			name: "nulová hodnota",
+			page: 0,
+			want: base + "?active=0&by=DESC&jazyk=&order=data&page=0&zaner=",
+		},
+		{
+			name:  "filtry a řazení",
+			query: ListingQuery{Category: 16, Genre: "Komédia", Language: "CZ", Order: OrderSeeds, Ascending: true, ActiveOnly: true},
+			page:  7,
+			want:  base + "?active=1&by=ASC&category=16&jazyk=CZ&order=seeders&page=7&zaner=Kom%C3%A9dia",
+		},
+		{
+			name:  "parametry z BaseURL se neopakují",
+			query: ListingQuery{BaseURL: base + "?active=0&order=data&by=DESC&zaner=&jazyk=&page=0"},
+			page:  3,
+			want:  base + "?active=0&by=DESC&jazyk=&order=data&page=3&zaner=",
+		},
+		{
+			name:  "vlastní parametr z BaseURL zůstane",
+			query: ListingQuery{BaseURL: "http://localhost:8080/torrents_v2.php?category=9&x=1"},
+			page:  1,
+			want:  "http://localhost:8080/torrents_v2.php?active=0&by=DESC&category=9&jazyk=&order=data&page=1&x=1&zaner=",
+		},
+		{
+			name:  "Category přepíše kategorii z BaseURL",
+			query: ListingQuery{BaseURL: base + "?category=9", Category: 3},
+			page:  0,
+			want:  base + "?active=0&by=DESC&category=3&jazyk=&order=data&page=0&zaner=",
+		},
+		{
+			name:  "neplatná BaseURL se vrátí beze změny",
+			query: ListingQuery{BaseURL: "http://[::1"},
+			page:  0,
+			want:  "http://[::1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.URL(tt.page); got != tt.want {
+				t.Errorf("URL(%d) = %q\n want %q", tt.page, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseListingOrder(t *testing.T) {
+	for name, want := range listingOrderNames {
+		got, err := ParseListingOrder(" " + strings.ToUpper(name))
+		if err != nil || got != want {
+			t.Errorf("ParseListingOrder(%q) = %q, %v; want %q", name, got, err, want)
+		}
+	}
+	if _, err := ParseListingOrder("data"); err == nil {
+		t.Error(`ParseListingOrder("data"): expected error`)
+	}
+}
+
+func TestListingQueryString(t *testing.T) {
+	tests := []struct {
+		query ListingQuery
+		want  string
+	}{
+		{ListingQuery{}, "celý výpis"},
+		{ListingQuery{Order: OrderDate}, "celý výpis"},
+		{ListingQuery{Category: 16, Language: "CZ", Order: OrderSize, ActiveOnly: true}, "kategorie 16, jazyk CZ, řazení size, jen aktivní"},
+	}
+	for _, tt := range tests {
+		if got := tt.query.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestResolveCategory(t *testing.T) {
+	const page = `<html><body>
+<a href="torrents_v2.php?category=1">Filmy CZ/SK dabing</a>
+<a href="torrents_v2.php?category=16">Seriál</a>
+<a href="torrents_v2.php?category=16">Seriál</a>
+<a href="torrents_v2.php?category=abc">Rozbitý</a>
+</body></html>`
+	fetcher := fetcherFunc(func(req *http.Request) (*http.Response, error) {
+		return textResponse(req, http.StatusOK, page, nil), nil
+	})
+	c := NewCrawler(Config{Fetcher: fetcher, Observer: ObserverFunc(func(Event) {})})
+
+	got, err := c.ResolveCategory(context.Background(), "  SERIAL ")
+	if err != nil || got != 16 {
+		t.Errorf("ResolveCategory(SERIAL) = %d, %v; want 16", got, err)
+	}
+
+	_, err = c.ResolveCategory(context.Background(), "Hudba")
+	if err == nil {
+		t.Fatal("ResolveCategory(Hudba): expected error")
+	}
+	if want := "known: Filmy CZ/SK dabing, Seriál"; !strings.Contains(err.Error(), want) {
+		t.Errorf("error %q does not list known categories (%s)", err, want)
+	}
+}
@@ -0,0 +1,60 @@
+package crawler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/database"
+)
+
+// lookupPageCache vrátí stav stránky z minulého uložení. Bez databáze,
+// s Config.IgnorePageCache nebo při chybě vrátí nil - stránka se pak
+// zpracuje celá.
+//
+// Klíčem je URL stránky výpisu, ne její obsah. Výchozí výpis je řazený
+// podle data sestupně (order=data&by=DESC), takže každý nový upload posune
+// obsah všech stránek o řádek a stav z minula se shoduje jen tehdy, když
+// mezi dvěma staženími nic nepřibylo: typicky při opakování (-retry-failed,
+// -resume) nebo u výpisů s jiným řazením či filtrem. Při běžném crawlu
+// nejnovějších stránek se cache trefí zřídka a stránka se zpracuje celá.
+func (c *Crawler) lookupPageCache(ctx context.Context, pageNum int, url string) *database.PageCache {
+	if c.config.Database == nil || c.config.IgnorePageCache {
+		return nil
+	}
+
+	cached, err := c.config.Database.GetPageCache(ctx, url)
+	if err != nil {
+		c.emitError(pageNum, "čtení stavu stránky", err)
+		return nil
+	}
+	return cached
+}
+
+// conditionalHeader vrátí hlavičky podmíněného požadavku podle minulé
+// odpovědi; server bez změny obsahu odpoví 304 bez těla
+func conditionalHeader(cached *database.PageCache) http.Header {
+	if cached == nil {
+		return nil
+	}
+	header := make(http.Header)
+	if cached.ETag != "" {
+		header.Set("If-None-Match", cached.ETag)
+	}
+	if cached.LastModified != "" {
+		header.Set("If-Modified-Since", cached.LastModified)
+	}
+	return header
+}
+
+// isConditional zjistí, zda požadavek připouští odpověď 304
+func isConditional(req *http.Request) bool {
+	return req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != ""
+}
+
+// bodyHash vrátí sha256 těla odpovědi jako hex
+func bodyHash(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,219 @@
+package crawler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/database"
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/parser"
+)
+
+func openTestDB(t *testing.T) *database.Database {
+	t.Helper()
+	db, err := database.NewDatabase(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestConditionalHeader(t *testing.T) {
+	tests := []struct {
+		name   string
+		cached *database.PageCache
+		want   http.Header
+	}{
+		{"bez stavu", nil, nil},
+		{"stav bez validátorů", &database.PageCache{BodyHash: "x"}, http.Header{}},
+		{"ETag", &database.PageCache{ETag: `"v1"`}, http.Header{"If-None-Match": {`"v1"`}}},
+		{
+			"ETag i Last-Modified",
+			&database.PageCache{ETag: `"v1"`, LastModified: "Wed, 02 Jul 2025 10:00:00 GMT"},
+			http.Header{"If-None-Match": {`"v1"`}, "If-Modified-Since": {"Wed, 02 Jul 2025 10:00:00 GMT"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := conditionalHeader(tt.cached)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) || (got == nil) != (tt.want == nil) {
+				t.Errorf("conditionalHeader = %v, want %v", got, tt.want)
+			}
+			req, _ := http.NewRequest(http.MethodGet, "https://sktorrent.eu/", nil)
+			for name, values := range got {
+				req.Header[name] = values
+			}
+			if want := len(tt.want) > 0; isConditional(req) != want {
+				t.Errorf("isConditional = %v, want %v", isConditional(req), want)
+			}
+		})
+	}
+}
+
+func TestLookupPageCache(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+	const url = "https://sktorrent.eu/torrent/torrents_v2.php?page=0"
+	err := db.InTx(ctx, func(tx *database.Tx) error {
+		return tx.SavePageCache(ctx, database.PageCache{URL: url, ETag: `"v1"`, BodyHash: "abc", TorrentCount: 3})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	quiet := ObserverFunc(func(Event) {})
+	tests := []struct {
+		name   string
+		config Config
+		url    string
+		want   bool
+	}{
+		{"uložená stránka", Config{Database: db}, url, true},
+		{"neznámá stránka", Config{Database: db}, url + "1", false},
+		{"bez databáze", Config{}, url, false},
+		{"-force", Config{Database: db, IgnorePageCache: true}, url, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.Observer = quiet
+			got := NewCrawler(tt.config).lookupPageCache(ctx, 0, tt.url)
+			if (got != nil) != tt.want {
+				t.Fatalf("lookupPageCache = %+v, want found=%v", got, tt.want)
+			}
+			if got != nil && (got.ETag != `"v1"` || got.BodyHash != "abc" || got.TorrentCount != 3) {
+				t.Errorf("lookupPageCache = %+v", got)
+			}
+		})
+	}
+}
+
+// cacheSite je falešný sktorrent s jednou stránkou výpisu s ETag
+type cacheSite struct {
+	listing     string
+	detailError bool // detaily odpovídají 500
+
+	mu          sync.Mutex
+	conditional int // podmíněné požadavky na výpis
+}
+
+func (s *cacheSite) Fetch(req *http.Request) (*http.Response, error) {
+	if strings.Contains(req.URL.Path, "details.php") {
+		if s.detailError {
+			return textResponse(req, http.StatusInternalServerError, "", nil), nil
+		}
+		return textResponse(req, http.StatusOK, "<html><body></body></html>", nil), nil
+	}
+	etag := `"listing-v1"`
+	if isConditional(req) {
+		s.mu.Lock()
+		s.conditional++
+		s.mu.Unlock()
+		if req.Header.Get("If-None-Match") == etag {
+			return textResponse(req, http.StatusNotModified, "", http.Header{"Etag": {etag}}), nil
+		}
+	}
+	return textResponse(req, http.StatusOK, s.listing, http.Header{"Etag": {etag}}), nil
+}
+
+// datedListingHTML sestaví stránku výpisu s jedním torrentem pro každé datum (DD/MM/YYYY)
+func datedListingHTML(dates ...string) string {
+	var b strings.Builder
+	b.WriteString(`<html><body><table class="lista"><tr>`)
+	for i, date := range dates {
+		fmt.Fprintf(&b, `<td class="lista"><a href="torrents_v2.php?category=1">Filmy</a><br>
+<a href="details.php?name=film&amp;id=%040x"><b>Film %d (2025)(CZ) = CSFD 80%%</b></a><br>
+<div style="font-size:11px">Velkost 1.5 GB | Pridany %s<br>
+Odosielaju : 10<br>
+Stahuju : 1
+</div></td>
+`, i+1, i, date)
+	}
+	b.WriteString(`</tr></table></body></html>`)
+	return b.String()
+}
+
+func TestPageCacheAcrossRuns(t *testing.T) {
+	sinceDate := time.Date(2025, 7, 1, 0, 0, 0, 0, parser.Location)
+	listing := datedListingHTML("05/07/2025", "04/07/2025", "03/07/2025", "02/07/2025", "01/07/2025")
+	mixed := datedListingHTML("05/07/2025", "04/07/2025", "03/07/2025", "30/06/2025", "29/06/2025")
+
+	tests := []struct {
+		name          string
+		listing       string
+		config        Config // pro oba běhy
+		detailError   bool
+		wantSaved     bool // první běh uloží stav stránky
+		wantUnchanged bool // druhý běh stránku přeskočí jako nezměněnou
+		wantCond      int  // podmíněné požadavky na výpis ve druhém běhu
+	}{
+		{name: "uložená stránka se podruhé přeskočí", listing: listing, wantSaved: true, wantUnchanged: true, wantCond: 1},
+		{
+			// Stav se uloží, ale nečte se: bez podmíněných požadavků
+			name: "-force stránku zpracuje znovu", listing: listing,
+			config: Config{IgnorePageCache: true}, wantSaved: true,
+		},
+		{
+			name: "-since oříznutá stránka stav neuloží", listing: mixed,
+			config: Config{Incremental: true, Since: sinceDate},
+		},
+		{
+			name: "-since bez oříznutí stav uloží", listing: listing,
+			config: Config{Incremental: true, Since: sinceDate}, wantSaved: true, wantUnchanged: true, wantCond: 1,
+		},
+		{name: "neúspěšné detaily stav neuloží", listing: listing, detailError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			site := &cacheSite{listing: tt.listing, detailError: tt.detailError}
+			config := tt.config
+			config.Database = openTestDB(t)
+			config.Fetcher = site
+			config.Workers = 1
+			config.Retry = RetryPolicy{MaxAttempts: 1}
+
+			run := func() []EventType {
+				var events []EventType
+				config.Observer = ObserverFunc(func(e Event) { events = append(events, e.Type) })
+				NewCrawler(config).Crawl(ctx, 0, 0)
+				return events
+			}
+
+			first := run()
+			if contains(first, EventPageUnchanged) {
+				t.Fatalf("first run: page reported unchanged: %v", first)
+			}
+			cached, err := config.Database.GetPageCache(ctx, config.Listing.URL(0))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if saved := cached != nil; saved != tt.wantSaved {
+				t.Errorf("page cache saved = %v, want %v", saved, tt.wantSaved)
+			}
+
+			site.conditional = 0
+			second := run()
+			if got := contains(second, EventPageUnchanged); got != tt.wantUnchanged {
+				t.Errorf("second run unchanged = %v, want %v (events %v)", got, tt.wantUnchanged, second)
+			}
+			if site.conditional != tt.wantCond {
+				t.Errorf("conditional requests = %d, want %d", site.conditional, tt.wantCond)
+			}
+		})
+	}
+}
+
+func contains(events []EventType, want EventType) bool {
+	for _, e := range events {
+		if e == want {
+			return true
+		}
+	}
+	return false
+}
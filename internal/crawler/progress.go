@@ -0,0 +1,110 @@
+package crawler
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// progressWidth je šířka pruhu ve znacích
+const progressWidth = 30
+
+// ProgressObserver vykresluje na terminál jeden průběžně přepisovaný řádek
+// s pruhem postupu místo výpisu každého torrentu. Chyby, zastavení a
+// závěrečné shrnutí předává ConsoleObserveru.
+type ProgressObserver struct {
+	w       io.Writer
+	console *ConsoleObserver
+
+	start     time.Time
+	total     int
+	done      int
+	failed    int
+	unchanged int
+	torrents  int
+	newCount  int
+	retries   int
+	workers   int
+	lineDrawn bool
+}
+
+func NewProgressObserver(w io.Writer) *ProgressObserver {
+	return &ProgressObserver{w: w, console: NewConsoleObserver(w)}
+}
+
+func (o *ProgressObserver) Observe(e Event) {
+	o.workers = e.Workers
+	switch e.Type {
+	case EventRunStarted:
+		o.start = e.Time
+		o.total = e.Count
+		o.done, o.failed, o.unchanged, o.torrents, o.newCount, o.retries = 0, 0, 0, 0, 0, 0
+		o.console.Observe(e)
+	case EventPageSaved:
+		o.done++
+		o.torrents += e.Count
+	case EventPageUnchanged:
+		o.done++
+		o.unchanged++
+	case EventPageFailed:
+		o.done++
+		o.failed++
+		o.passThrough(e)
+	case EventTorrentNew:
+		o.newCount++
+	case EventRetry:
+		o.retries++
+	case EventConcurrency:
+	case EventError, EventNotice, EventStopping:
+		o.passThrough(e)
+	case EventRunFinished:
+		o.draw(e.Time)
+		fmt.Fprintln(o.w)
+		o.lineDrawn = false
+		o.console.Observe(e)
+		return
+	default:
+		return
+	}
+	o.draw(e.Time)
+}
+
+// passThrough vypíše událost přes ConsoleObserver pod pruh postupu
+func (o *ProgressObserver) passThrough(e Event) {
+	if o.lineDrawn {
+		fmt.Fprint(o.w, "\r\033[K")
+		o.lineDrawn = false
+	}
+	o.console.Observe(e)
+}
+
+func (o *ProgressObserver) draw(now time.Time) {
+	filled := 0
+	if o.total > 0 {
+		filled = o.done * progressWidth / o.total
+	}
+	if filled > progressWidth {
+		filled = progressWidth
+	}
+	bar := strings.Repeat("█", filled) + strings.Repeat("░", progressWidth-filled)
+
+	line := fmt.Sprintf("\r\033[K[%s] %d/%d stránek | %d torrentů (%d nových)", bar, o.done, o.total, o.torrents, o.newCount)
+	if o.unchanged > 0 {
+		line += fmt.Sprintf(" | ♻️  %d", o.unchanged)
+	}
+	if o.failed > 0 {
+		line += fmt.Sprintf(" | ❌ %d", o.failed)
+	}
+	if o.retries > 0 {
+		line += fmt.Sprintf(" | 🔁 %d", o.retries)
+	}
+	if o.workers > 0 {
+		line += fmt.Sprintf(" | ⚙️  %d", o.workers)
+	}
+	if !o.start.IsZero() {
+		line += fmt.Sprintf(" | ⏱️  %v", now.Sub(o.start).Round(time.Second))
+	}
+	fmt.Fprint(o.w, line)
+	o.lineDrawn = true
+}
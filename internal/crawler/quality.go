@@ -0,0 +1,72 @@
+package crawler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/parser"
+)
+
+// QualityPolicy určuje, kdy výsledek stránky vypadá jako změna layoutu
+// sktorrentu. Porušení kteréhokoli limitu je LayoutChangedError.
+type QualityPolicy struct {
+	Disabled   bool
+	MaxMissing float64 // max. podíl nepřečtených řádků a řádků bez jednoho údaje (default 0.2)
+	MinRows    int     // podíly údajů se hlídají až od tolika řádků na stránce (default 5)
+}
+
+// DefaultQualityPolicy toleruje pětinu vadných řádků
+var DefaultQualityPolicy = QualityPolicy{
+	MaxMissing: 0.2,
+	MinRows:    5,
+}
+
+// qualityFields jsou údaje, jejichž chybění se hlídá
+var qualityFields = []string{
+	parser.FieldSize,
+	parser.FieldDate,
+	parser.FieldSeeds,
+	parser.FieldCategory,
+}
+
+// checkQuality ověří přečtenou stránku výpisu. Vrátí popis prvního
+// porušeného limitu, nebo "" když stránka vypadá v pořádku.
+func (p QualityPolicy) checkQuality(pageNum int, page parser.ListingPage) string {
+	if p.Disabled {
+		return ""
+	}
+	rows := len(page.Listings)
+
+	// Prázdná stránka je konec výpisu, první stránka ale prázdná být nemá
+	if rows == 0 && page.DetailLinks == 0 {
+		if pageNum == 0 {
+			return "first listing page has no torrents"
+		}
+		return ""
+	}
+
+	// Odkazy na detaily bez přečtených řádků = parser nepoznává tabulku
+	if expected := page.DetailLinks; expected > 0 {
+		unread := expected - rows
+		if unread > 0 && float64(unread) > p.MaxMissing*float64(expected) {
+			return fmt.Sprintf("parsed %d of %d torrents linked from the page", rows, expected)
+		}
+	}
+
+	if rows < p.MinRows {
+		return ""
+	}
+	missing := make(map[string]int)
+	for _, l := range page.Listings {
+		for _, field := range l.Missing {
+			missing[field]++
+		}
+	}
+	var problems []string
+	for _, field := range qualityFields {
+		if share := float64(missing[field]) / float64(rows); share > p.MaxMissing {
+			problems = append(problems, fmt.Sprintf("%d/%d rows without %s", missing[field], rows, field))
+		}
+	}
+	return strings.Join(problems, ", ")
+}
@@ -0,0 +1,82 @@
+package crawler
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/parser"
+)
+
+// listingHTML sestaví stránku výpisu: rows celých řádků, unnamed řádků bez
+// názvu (parser je nepřečte), noSize řádků bez velikosti a sidebar odkazů
+// na detaily mimo výpis
+func listingHTML(rows, unnamed, noSize, sidebar int) string {
+	var b strings.Builder
+	b.WriteString("<html><body><ul id=\"sidebar\">\n")
+	for i := range sidebar {
+		fmt.Fprintf(&b, "<li><a href=\"details.php?name=top&amp;id=%040x\">Top %d</a></li>\n", 0xf000+i, i)
+	}
+	b.WriteString("</ul><table class=\"lista\"><tr>\n")
+	for i := range rows + unnamed + noSize {
+		name := fmt.Sprintf("Film %d (2025)(CZ)", i)
+		if i >= rows && i < rows+unnamed {
+			name = ""
+		}
+		size := "Velkost 1.5 GB | "
+		if i >= rows+unnamed {
+			size = ""
+		}
+		fmt.Fprintf(&b, `<td class="lista"><a href="torrents_v2.php?category=1">Filmy</a><br>
+<a href="details.php?name=film&amp;id=%040x"><b>%s</b></a><br>
+<div style="font-size:11px">%sPridany 02/07/2025<br>
+Odosielaju : 10<br>
+Stahuju : 1
+</div></td>
+`, i+1, name, size)
+	}
+	b.WriteString("</tr></table></body></html>")
+	return b.String()
+}
+
+func TestCheckQuality(t *testing.T) {
+	tests := []struct {
+		name    string
+		page    int
+		html    string
+		policy  QualityPolicy
+		wantErr string // část popisu problému, "" = stránka v pořádku
+	}{
+		{name: "celá stránka", html: listingHTML(20, 0, 0, 0)},
+		{name: "prázdná první stránka", page: 0, html: listingHTML(0, 0, 0, 0), wantErr: "first listing page has no torrents"},
+		{name: "prázdná stránka za koncem výpisu", page: 5, html: listingHTML(0, 0, 0, 0)},
+		{name: "odkazy mimo výpis se nepočítají", html: listingHTML(8, 0, 0, 24)},
+		{name: "prázdný výpis s postranním panelem", page: 5, html: listingHTML(0, 0, 0, 12)},
+		{name: "nepřečtené řádky výpisu", html: listingHTML(6, 4, 0, 12), wantErr: "parsed 6 of 10 torrents"},
+		{name: "nepřečtené řádky v toleranci", html: listingHTML(9, 1, 0, 12)},
+		{name: "řádky bez velikosti", html: listingHTML(7, 0, 3, 0), wantErr: "3/10 rows without size"},
+		{name: "málo řádků na podíly", html: listingHTML(2, 0, 2, 0)},
+		{name: "vypnutá kontrola", page: 0, html: listingHTML(0, 0, 0, 0), policy: QualityPolicy{Disabled: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := parser.ParseListingPage(strings.NewReader(tt.html), time.Now())
+			if err != nil {
+				t.Fatal(err)
+			}
+			policy := tt.policy
+			if !policy.Disabled {
+				policy = DefaultQualityPolicy
+			}
+
+			got := policy.checkQuality(tt.page, page)
+			if tt.wantErr == "" && got != "" {
+				t.Errorf("checkQuality = %q, want no problem", got)
+			}
+			if tt.wantErr != "" && !strings.Contains(got, tt.wantErr) {
+				t.Errorf("checkQuality = %q, want %q", got, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package crawler
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// HostLimiter omezuje počet požadavků za sekundu zvlášť pro každý host
+// (token bucket). Sdílí ho všechny workery, takže pokrývá výpisy i detaily.
+type HostLimiter struct {
+	rate  float64 // požadavků za sekundu, <= 0 znamená bez limitu
+	burst int
+
+	mu    sync.Mutex
+	hosts map[string]*tokenBucket
+}
+
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func NewHostLimiter(rate float64, burst int) *HostLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &HostLimiter{
+		rate:  rate,
+		burst: burst,
+		hosts: make(map[string]*tokenBucket),
+	}
+}
+
+// SetCrawlDelay zpomalí host na nejvýše jeden požadavek za delay
+// (Crawl-delay z robots.txt). Rychlejší limit z konfigurace se nepoužije.
+func (l *HostLimiter) SetCrawlDelay(host string, delay time.Duration) {
+	if delay <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b := l.bucket(host)
+	delayRate := 1 / delay.Seconds()
+	if b.rate <= 0 || delayRate < b.rate {
+		b.rate = delayRate
+		b.burst = 1
+		if b.tokens > 1 {
+			b.tokens = 1
+		}
+	}
+}
+
+// Wait blokuje, dokud host nedovolí další požadavek, nebo dokud není ctx zrušen
+func (l *HostLimiter) Wait(ctx context.Context, host string) error {
+	l.mu.Lock()
+	b := l.bucket(host)
+	if b.rate <= 0 {
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+
+	// Token si rezervujeme hned, případné čekání proběhne mimo zámek
+	b.tokens--
+	var wait time.Duration
+	if b.tokens < 0 {
+		wait = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	return sleepContext(ctx, wait)
+}
+
+// bucket vrátí (případně založí) bucket hostu; volající drží l.mu
+func (l *HostLimiter) bucket(host string) *tokenBucket {
+	b, ok := l.hosts[host]
+	if !ok {
+		b = &tokenBucket{
+			rate:   l.rate,
+			burst:  float64(l.burst),
+			tokens: float64(l.burst),
+			last:   time.Now(),
+		}
+		l.hosts[host] = b
+	}
+	return b
+}
@@ -0,0 +1,90 @@
+package crawler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// waitN zavolá Wait n-krát a vrátí, jak dlouho to trvalo
+func waitN(t *testing.T, l *HostLimiter, host string, n int) time.Duration {
+	t.Helper()
+	start := time.Now()
+	for range n {
+		if err := l.Wait(context.Background(), host); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return time.Since(start)
+}
+
+func TestHostLimiterUnlimited(t *testing.T) {
+	l := NewHostLimiter(0, 0)
+	if d := waitN(t, l, "a", 1000); d > 100*time.Millisecond {
+		t.Errorf("unlimited limiter waited %v", d)
+	}
+}
+
+func TestHostLimiterBurstThenRate(t *testing.T) {
+	l := NewHostLimiter(20, 3) // jeden token každých 50 ms
+
+	if d := waitN(t, l, "a", 3); d > 20*time.Millisecond {
+		t.Errorf("burst of 3 waited %v", d)
+	}
+	// Další dva požadavky musí počkat na nové tokeny
+	if d := waitN(t, l, "a", 2); d < 80*time.Millisecond {
+		t.Errorf("2 requests after burst took %v, want >= ~100ms", d)
+	}
+}
+
+func TestHostLimiterPerHost(t *testing.T) {
+	l := NewHostLimiter(10, 1)
+	waitN(t, l, "a", 1)
+	// Vyčerpaný host "a" nesmí zdržet host "b"
+	if d := waitN(t, l, "b", 1); d > 20*time.Millisecond {
+		t.Errorf("other host waited %v", d)
+	}
+}
+
+func TestHostLimiterCrawlDelay(t *testing.T) {
+	tests := []struct {
+		name    string
+		rate    float64
+		delay   time.Duration
+		minWait time.Duration
+		maxWait time.Duration
+	}{
+		// Crawl-delay zruší burst: první požadavek projde, další dva čekají
+		{"zpomalí neomezený host", 0, 60 * time.Millisecond, 100 * time.Millisecond, time.Second},
+		{"zpomalí rychlejší limit", 100, 60 * time.Millisecond, 100 * time.Millisecond, time.Second},
+		// Pomalejší limit i jeho burst zůstanou, 3 požadavky projdou hned
+		{"pomalejší limit z konfigurace zůstane", 5, 10 * time.Millisecond, 0, 20 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewHostLimiter(tt.rate, 5)
+			l.SetCrawlDelay("a", tt.delay)
+			d := waitN(t, l, "a", 3)
+			if d < tt.minWait || d > tt.maxWait {
+				t.Errorf("3 requests took %v, want between %v and %v", d, tt.minWait, tt.maxWait)
+			}
+		})
+	}
+}
+
+func TestHostLimiterWaitCanceled(t *testing.T) {
+	l := NewHostLimiter(1, 1)
+	waitN(t, l, "a", 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := l.Wait(ctx, "a")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait = %v, want context.DeadlineExceeded", err)
+	}
+	if d := time.Since(start); d > 500*time.Millisecond {
+		t.Errorf("canceled Wait took %v", d)
+	}
+}
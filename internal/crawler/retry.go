@@ -0,0 +1,83 @@
+package crawler
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxRetryAfter omezuje, jak dlouho jsme ochotni čekat na Retry-After od serveru
+const maxRetryAfter = 5 * time.Minute
+
+// RetryPolicy určuje, kolikrát a s jakou prodlevou se opakuje neúspěšný
+// požadavek na výpis nebo detail
+type RetryPolicy struct {
+	MaxAttempts int           // celkový počet pokusů včetně prvního (1 = bez opakování)
+	BaseDelay   time.Duration // prodleva před prvním opakováním
+	MaxDelay    time.Duration // strop exponenciálního backoffu
+}
+
+// DefaultRetryPolicy se použije, když Config.Retry není vyplněná
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   time.Second,
+	MaxDelay:    30 * time.Second,
+}
+
+// backoff vrátí prodlevu před dalším pokusem: exponenciálně rostoucí
+// s náhodným jitterem v rozsahu <d/2, d>
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseDelay
+	for i := 1; i < attempt && d < p.MaxDelay; i++ {
+		d *= 2
+	}
+	if d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if d <= 0 {
+		return 0
+	}
+	half := d / 2
+	return half + rand.N(half+1)
+}
+
+// parseRetryAfter přečte hlavičku Retry-After (sekundy nebo HTTP datum)
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	var d time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil {
+		d = time.Duration(seconds) * time.Second
+	} else if at, err := http.ParseTime(value); err == nil {
+		d = at.Sub(now)
+	}
+
+	if d < 0 {
+		return 0
+	}
+	if d > maxRetryAfter {
+		return maxRetryAfter
+	}
+	return d
+}
+
+// sleepContext počká d, nebo skončí dřív se zrušením ctx
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,110 @@
+package crawler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 10, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	tests := []struct {
+		attempt int
+		max     time.Duration // backoff je v rozsahu <max/2, max>
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond},
+		{4, 800 * time.Millisecond},
+		{5, time.Second}, // strop MaxDelay
+		{20, time.Second},
+	}
+	for _, tt := range tests {
+		for range 50 {
+			d := p.backoff(tt.attempt)
+			if d < tt.max/2 || d > tt.max {
+				t.Fatalf("backoff(%d) = %v, want between %v and %v", tt.attempt, d, tt.max/2, tt.max)
+			}
+		}
+	}
+
+	if d := (RetryPolicy{MaxAttempts: 3}).backoff(1); d != 0 {
+		t.Errorf("backoff without BaseDelay = %v, want 0", d)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 7, 2, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"", 0},
+		{"  ", 0},
+		{"120", 2 * time.Minute},
+		{" 5 ", 5 * time.Second},
+		{"0", 0},
+		{"-3", 0},
+		{"3600", maxRetryAfter},
+		{now.Add(90 * time.Second).Format(http.TimeFormat), 90 * time.Second},
+		{now.Add(-time.Minute).Format(http.TimeFormat), 0},
+		{now.Add(time.Hour).Format(http.TimeFormat), maxRetryAfter},
+		{"zítra", 0},
+	}
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.value, now); got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestSleepContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := sleepContext(ctx, time.Hour); !errors.Is(err, context.Canceled) {
+		t.Errorf("canceled sleep = %v, want context.Canceled", err)
+	}
+	if err := sleepContext(context.Background(), time.Millisecond); err != nil {
+		t.Errorf("sleep = %v", err)
+	}
+}
+
+func TestFetchRetries(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []int
+		wantErr  bool
+		wantGets int
+	}{
+		{"503 se opakuje", []int{503, 503, 200}, false, 3},
+		{"po MaxAttempts se vzdá", []int{503, 503, 503, 200}, true, 3},
+		{"404 se neopakuje", []int{404, 200}, true, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gets := 0
+			fetcher := fetcherFunc(func(req *http.Request) (*http.Response, error) {
+				status := tt.statuses[gets]
+				gets++
+				return textResponse(req, status, "ok", nil), nil
+			})
+			c := NewCrawler(Config{
+				Fetcher:  fetcher,
+				Observer: ObserverFunc(func(Event) {}),
+				Retry:    RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond},
+			})
+
+			_, err := c.get(context.Background(), "https://sktorrent.eu/torrent/torrents_v2.php")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("get error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if gets != tt.wantGets {
+				t.Errorf("fetched %d times, want %d", gets, tt.wantGets)
+			}
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package crawler
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// robotsRules jsou pravidla z robots.txt platná pro náš User-Agent
+type robotsRules struct {
+	rules      []robotsRule
+	crawlDelay time.Duration
+}
+
+// robotsRule je jeden řádek Allow/Disallow převedený na regexp
+type robotsRule struct {
+	pattern string
+	re      *regexp.Regexp
+	allow   bool
+}
+
+// robotsGroup je jedna skupina "User-agent: ..." s jejími pravidly
+type robotsGroup struct {
+	agents []string
+	rules  robotsRules
+}
+
+// parseRobots načte robots.txt a vybere skupinu pro userAgent. Skupina
+// s odpovídajícím jménem crawleru má přednost před "*".
+func parseRobots(r io.Reader, userAgent string) *robotsRules {
+	var groups []*robotsGroup
+	var current *robotsGroup
+	lastWasAgent := false
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		if key == "user-agent" {
+			// Po sobě jdoucí User-agent řádky patří do jedné skupiny
+			if current == nil || !lastWasAgent {
+				current = &robotsGroup{}
+				groups = append(groups, current)
+			}
+			current.agents = append(current.agents, strings.ToLower(value))
+			lastWasAgent = true
+			continue
+		}
+		lastWasAgent = false
+		if current == nil {
+			continue
+		}
+
+		switch key {
+		case "allow", "disallow":
+			// Prázdný Disallow znamená "povoleno vše"
+			if value != "" {
+				current.rules.rules = append(current.rules.rules, robotsRule{
+					pattern: value,
+					re:      robotsPattern(value),
+					allow:   key == "allow",
+				})
+			}
+		case "crawl-delay":
+			if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds > 0 {
+				current.rules.crawlDelay = time.Duration(seconds * float64(time.Second))
+			}
+		}
+	}
+
+	product := robotsProductToken(userAgent)
+	var wildcard *robotsGroup
+	for _, g := range groups {
+		for _, agent := range g.agents {
+			if agent == "*" {
+				if wildcard == nil {
+					wildcard = g
+				}
+			} else if product != "" && agent == product {
+				return &g.rules
+			}
+		}
+	}
+	if wildcard != nil {
+		return &wildcard.rules
+	}
+	return &robotsRules{}
+}
+
+// robotsProductToken vytáhne z User-Agent jméno crawleru
+// ("Mozilla/5.0 (compatible; SkTorrent-Crawler/1.0)" -> "sktorrent-crawler").
+// Skupina v robots.txt platí, jen když se s ním shoduje celé (RFC 9309).
+func robotsProductToken(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+	if i := strings.Index(ua, "compatible;"); i >= 0 {
+		ua = strings.TrimSpace(ua[i+len("compatible;"):])
+	}
+	ua = strings.TrimLeft(ua, " (")
+	if i := strings.IndexAny(ua, "/;) "); i >= 0 {
+		ua = ua[:i]
+	}
+	return ua
+}
+
+// Allowed rozhodne podle nejdelšího odpovídajícího pravidla; při shodě
+// délky vyhrává Allow
+func (r *robotsRules) Allowed(path string) bool {
+	best := -1
+	allowed := true
+	for _, rule := range r.rules {
+		if !rule.re.MatchString(path) {
+			continue
+		}
+		if len(rule.pattern) > best || (len(rule.pattern) == best && rule.allow) {
+			best = len(rule.pattern)
+			allowed = rule.allow
+		}
+	}
+	return allowed
+}
+
+// robotsPattern převede vzor z robots.txt (se zástupnými znaky * a $) na regexp
+func robotsPattern(pattern string) *regexp.Regexp {
+	anchored := strings.HasSuffix(pattern, "$")
+	pattern = strings.TrimSuffix(pattern, "$")
+
+	parts := strings.Split(pattern, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+	expr := "^" + strings.Join(parts, ".*")
+	if anchored {
+		expr += "$"
+	}
+	return regexp.MustCompile(expr)
+}
+
+// disallowAll jsou pravidla pro nedostupný robots.txt: podle RFC 9309 se
+// nesmí stahovat nic
+func disallowAll() *robotsRules {
+	return &robotsRules{rules: []robotsRule{{pattern: "/", re: robotsPattern("/"), allow: false}}}
+}
+
+// robotsCache drží robots.txt pro každý host. Úspěšně stažený (i 4xx =
+// bez omezení) se drží po celý běh, neúspěšný se zkusí znovu při dalším
+// požadavku.
+type robotsCache struct {
+	mu    sync.Mutex
+	hosts map[string]*robotsEntry
+}
+
+type robotsEntry struct {
+	mu    sync.Mutex
+	rules *robotsRules // nil = zatím nestaženo
+}
+
+// checkRobots ověří, že URL smí crawler stáhnout. Při prvním požadavku na
+// host stáhne jeho robots.txt a nastaví Crawl-delay do limiteru.
+func (c *Crawler) checkRobots(ctx context.Context, u *url.URL) error {
+	c.robots.mu.Lock()
+	entry, ok := c.robots.hosts[u.Host]
+	if !ok {
+		entry = &robotsEntry{}
+		c.robots.hosts[u.Host] = entry
+	}
+	c.robots.mu.Unlock()
+
+	entry.mu.Lock()
+	rules := entry.rules
+	if rules == nil {
+		var cached bool
+		rules, cached = c.fetchRobots(ctx, u)
+		if cached {
+			entry.rules = rules
+			if rules.crawlDelay > 0 {
+				c.limiter.SetCrawlDelay(u.Host, rules.crawlDelay)
+			}
+		}
+	}
+	entry.mu.Unlock()
+
+	path := u.EscapedPath()
+	if u.RawQuery != "" {
+		path += "?" + u.RawQuery
+	}
+	if !rules.Allowed(path) {
+		return fmt.Errorf("%s disallowed by robots.txt", u)
+	}
+	return nil
+}
+
+// fetchRobots stáhne robots.txt. Chybějící soubor (4xx) crawling nijak
+// neomezuje; při chybě sítě nebo 5xx se nesmí stahovat nic a výsledek se
+// neuloží (cached = false), aby se robots.txt zkusil znovu.
+func (c *Crawler) fetchRobots(ctx context.Context, u *url.URL) (rules *robotsRules, cached bool) {
+	robotsURL := u.Scheme + "://" + u.Host + "/robots.txt"
+
+	if err := c.limiter.Wait(ctx, u.Host); err != nil {
+		return disallowAll(), false
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", robotsURL, nil)
+	if err != nil {
+		return disallowAll(), false
+	}
+	req.Header.Set("User-Agent", c.config.UserAgent)
+
+	resp, err := c.fetcher.Fetch(req)
+	if err != nil {
+		c.emitError(NoPage, "stahování robots.txt pro "+u.Host, err)
+		return disallowAll(), false
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 500 {
+		c.emitError(NoPage, "stahování robots.txt pro "+u.Host, &HTTPStatusError{StatusCode: resp.StatusCode, URL: robotsURL})
+		return disallowAll(), false
+	}
+	if resp.StatusCode != http.StatusOK {
+		return &robotsRules{}, true
+	}
+
+	rules = parseRobots(io.LimitReader(resp.Body, 512*1024), c.config.UserAgent)
+	if rules.crawlDelay > 0 {
+		c.emit(Event{
+			Type:    EventNotice,
+			Page:    NoPage,
+			URL:     robotsURL,
+			Message: fmt.Sprintf("🤖 robots.txt pro %s: Crawl-delay %v", u.Host, rules.crawlDelay),
+		})
+	}
+	return rules, true
+}
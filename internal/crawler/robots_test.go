@@ -0,0 +1,194 @@
+package crawler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testUserAgent = "Mozilla/5.0 (compatible; SkTorrent-Crawler/1.0)"
+
+func TestParseRobotsAllowed(t *testing.T) {
+	robots := `
+# komentář
+User-agent: *
+Disallow: /torrent/download.php
+Disallow: /admin/
+Allow: /admin/public
+Disallow: /*.zip$
+Crawl-delay: 2
+
+User-agent: OtherBot
+Disallow: /
+`
+	rules := parseRobots(strings.NewReader(robots), testUserAgent)
+
+	if rules.crawlDelay != 2*time.Second {
+		t.Errorf("crawlDelay = %v, want 2s", rules.crawlDelay)
+	}
+
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"/torrent/torrents_v2.php?page=1", true},
+		{"/torrent/download.php?id=abc", false},
+		{"/admin/users", false},
+		{"/admin/public/index.html", true}, // delší Allow vyhrává
+		{"/files/archive.zip", false},
+		{"/files/archive.zip?x=1", true}, // $ ukotvuje konec cesty
+		{"/", true},
+	}
+	for _, tt := range tests {
+		if got := rules.Allowed(tt.path); got != tt.want {
+			t.Errorf("Allowed(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestParseRobotsAgentMatching(t *testing.T) {
+	tests := []struct {
+		name   string
+		robots string
+		want   bool // smí crawler stáhnout /torrent/
+	}{
+		{
+			name:   "vlastní skupina má přednost před *",
+			robots: "User-agent: *\nDisallow: /\n\nUser-agent: SkTorrent-Crawler\nAllow: /\n",
+			want:   true,
+		},
+		{
+			name:   "jméno se porovnává bez ohledu na velikost písmen",
+			robots: "User-agent: *\nAllow: /\n\nUser-agent: sktorrent-CRAWLER\nDisallow: /torrent/\n",
+			want:   false,
+		},
+		{
+			name:   "část jména nestačí",
+			robots: "User-agent: *\nAllow: /\n\nUser-agent: SkTorrent\nDisallow: /\n",
+			want:   true,
+		},
+		{
+			name:   "delší jméno nestačí",
+			robots: "User-agent: *\nAllow: /\n\nUser-agent: SkTorrent-Crawler-Pro\nDisallow: /\n",
+			want:   true,
+		},
+		{
+			name:   "více User-agent řádků v jedné skupině",
+			robots: "User-agent: OtherBot\nUser-agent: SkTorrent-Crawler\nDisallow: /torrent/\n",
+			want:   false,
+		},
+		{
+			name:   "bez odpovídající skupiny je povoleno vše",
+			robots: "User-agent: OtherBot\nDisallow: /\n",
+			want:   true,
+		},
+		{
+			name:   "prázdný Disallow",
+			robots: "User-agent: *\nDisallow:\n",
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := parseRobots(strings.NewReader(tt.robots), testUserAgent)
+			if got := rules.Allowed("/torrent/"); got != tt.want {
+				t.Errorf("Allowed(/torrent/) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRobotsProductToken(t *testing.T) {
+	tests := map[string]string{
+		testUserAgent:                "sktorrent-crawler",
+		"SkTorrent-Crawler/2.0":      "sktorrent-crawler",
+		"Googlebot":                  "googlebot",
+		"Mozilla/5.0 (X11; Linux)":   "mozilla",
+		"(compatible; MyBot; +http)": "mybot",
+	}
+	for ua, want := range tests {
+		if got := robotsProductToken(ua); got != want {
+			t.Errorf("robotsProductToken(%q) = %q, want %q", ua, got, want)
+		}
+	}
+}
+
+// robotsCrawler vrátí crawler, jehož robots.txt odpovídá postupně podle statuses
+func robotsCrawler(statuses ...int) (*Crawler, *atomic.Int32) {
+	var calls atomic.Int32
+	fetcher := fetcherFunc(func(req *http.Request) (*http.Response, error) {
+		n := int(calls.Add(1)) - 1
+		if n >= len(statuses) {
+			n = len(statuses) - 1
+		}
+		switch status := statuses[n]; status {
+		case 0:
+			return nil, errors.New("connection refused")
+		case http.StatusOK:
+			return textResponse(req, status, "User-agent: *\nDisallow: /private/\n", nil), nil
+		default:
+			return textResponse(req, status, "", nil), nil
+		}
+	})
+	c := NewCrawler(Config{
+		Fetcher:       fetcher,
+		UserAgent:     testUserAgent,
+		RespectRobots: true,
+		Observer:      ObserverFunc(func(Event) {}),
+	})
+	return c, &calls
+}
+
+func TestCheckRobots(t *testing.T) {
+	ctx := context.Background()
+	page, _ := url.Parse("https://sktorrent.eu/torrent/torrents_v2.php?page=1")
+	private, _ := url.Parse("https://sktorrent.eu/private/x")
+
+	t.Run("200 se uloží", func(t *testing.T) {
+		c, calls := robotsCrawler(http.StatusOK)
+		if err := c.checkRobots(ctx, page); err != nil {
+			t.Errorf("page: %v", err)
+		}
+		if err := c.checkRobots(ctx, private); err == nil {
+			t.Error("private: expected disallow")
+		}
+		if n := calls.Load(); n != 1 {
+			t.Errorf("robots.txt fetched %d times, want 1", n)
+		}
+	})
+
+	t.Run("4xx znamená bez omezení", func(t *testing.T) {
+		c, calls := robotsCrawler(http.StatusNotFound)
+		for range 2 {
+			if err := c.checkRobots(ctx, private); err != nil {
+				t.Errorf("private: %v", err)
+			}
+		}
+		if n := calls.Load(); n != 1 {
+			t.Errorf("robots.txt fetched %d times, want 1", n)
+		}
+	})
+
+	for _, first := range []int{http.StatusServiceUnavailable, 0} {
+		t.Run("chyba "+http.StatusText(first)+" zakáže vše a zkusí se znovu", func(t *testing.T) {
+			c, calls := robotsCrawler(first, http.StatusOK)
+			if err := c.checkRobots(ctx, page); err == nil {
+				t.Error("expected disallow while robots.txt is unavailable")
+			}
+			if err := c.checkRobots(ctx, page); err != nil {
+				t.Errorf("after recovery: %v", err)
+			}
+			if err := c.checkRobots(ctx, private); err == nil {
+				t.Error("private: expected disallow")
+			}
+			if n := calls.Load(); n != 2 {
+				t.Errorf("robots.txt fetched %d times, want 2", n)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrTorrentNotSaved hlásí stats torrentu, jehož upsert ve stejné dávce selhal
+var ErrTorrentNotSaved = errors.New("torrent not saved in this batch")
+
+// StatsSample je jeden záznam seeds/leeches do torrent_stats
+type StatsSample struct {
+	TorrentID  string
+	Seeds      int
+	Leeches    int
+	RecordedAt time.Time // nulový čas = okamžik uložení dávky
+}
+
+// RowError je chyba jednoho řádku dávky; ostatní řádky se přesto uloží
+type RowError struct {
+	Table string // "torrents" nebo "torrent_stats"
+	Index int    // pozice řádku v předaném slice
+	ID    string // ID torrentu
+	Err   error
+}
+
+func (e RowError) Error() string {
+	return fmt.Sprintf("%s[%d] %s: %v", e.Table, e.Index, e.ID, e.Err)
+}
+
+func (e RowError) Unwrap() error {
+	return e.Err
+}
+
+// BatchResult shrnuje uloženou dávku
+type BatchResult struct {
+	Torrents       int // uložené torrenty
+	Stats          int // uložené záznamy stats
+	StatsUnchanged int // stats shodné s posledním záznamem (nezapisují se)
+	Failed         []RowError
+}
+
+// SaveBatch uloží torrenty a jejich stats v jedné transakci přes připravené
+// dotazy. Chyba jednoho řádku dávku nezruší, objeví se v BatchResult.Failed;
+// stats torrentu, který se nepodařilo uložit, se přeskočí, stats shodné
+// s posledním záznamem se nezapíšou (StatsUnchanged). Vrácená chyba
+// znamená, že se neuložilo nic.
+func (d *Database) SaveBatch(ctx context.Context, torrents []Torrent, stats []StatsSample) (BatchResult, error) {
+	var result BatchResult
+	err := d.InTx(ctx, func(tx *Tx) error {
+		var err error
+		result, err = tx.SaveBatch(ctx, torrents, stats)
+		return err
+	})
+	if err != nil {
+		return BatchResult{}, err
+	}
+	return result, nil
+}
+
+// SaveBatch zapíše dávku v rámci rozpracované transakce (viz Database.SaveBatch)
+func (t *Tx) SaveBatch(ctx context.Context, torrents []Torrent, stats []StatsSample) (BatchResult, error) {
+	var result BatchResult
+
+	torrentStmt, err := t.tx.PrepareContext(ctx, upsertTorrentQuery)
+	if err != nil {
+		return result, fmt.Errorf("preparing torrent upsert: %w", err)
+	}
+	defer torrentStmt.Close()
+
+	statsStmt, err := t.tx.PrepareContext(ctx, insertStatsQuery)
+	if err != nil {
+		return result, fmt.Errorf("preparing stats insert: %w", err)
+	}
+	defer statsStmt.Close()
+
+	now := time.Now()
+	failedIDs := make(map[string]bool)
+
+	for i := range torrents {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		torrent := &torrents[i]
+		if _, err := torrentStmt.ExecContext(ctx, torrentValues(torrent, now)...); err != nil {
+			result.Failed = append(result.Failed, RowError{Table: "torrents", Index: i, ID: torrent.ID, Err: err})
+			failedIDs[torrent.ID] = true
+			continue
+		}
+		result.Torrents++
+	}
+
+	for i, sample := range stats {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		if failedIDs[sample.TorrentID] {
+			result.Failed = append(result.Failed, RowError{Table: "torrent_stats", Index: i, ID: sample.TorrentID, Err: ErrTorrentNotSaved})
+			continue
+		}
+		recordedAt := sample.RecordedAt
+		if recordedAt.IsZero() {
+			recordedAt = now
+		}
+		res, err := statsStmt.ExecContext(ctx, sample.TorrentID, sample.Seeds, sample.Leeches, recordedAt)
+		if err != nil {
+			result.Failed = append(result.Failed, RowError{Table: "torrent_stats", Index: i, ID: sample.TorrentID, Err: err})
+			continue
+		}
+		if n, err := res.RowsAffected(); err == nil && n == 0 {
+			result.StatsUnchanged++
+			continue
+		}
+		result.Stats++
+	}
+
+	return result, nil
+}
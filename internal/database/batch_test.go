@@ -0,0 +1,149 @@
+package database
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// batchSize je velikost dávky, po které ukládá crawler
+const batchSize = 1000
+
+func TestSaveBatchFailedRow(t *testing.T) {
+	db := newTestDatabase(t)
+	ctx := context.Background()
+
+	torrents, stats := generateTorrents(5)
+	// NaN se v SQLite uloží jako NULL a size_mb je NOT NULL
+	torrents[2].SizeMB = math.NaN()
+
+	result, err := db.SaveBatch(ctx, torrents, stats)
+	if err != nil {
+		t.Fatalf("SaveBatch: %v", err)
+	}
+	if result.Torrents != 4 || result.Stats != 4 {
+		t.Errorf("saved %d torrents and %d stats, want 4 and 4", result.Torrents, result.Stats)
+	}
+	if len(result.Failed) != 2 {
+		t.Fatalf("Failed = %v, want the torrent and its stats", result.Failed)
+	}
+	if f := result.Failed[0]; f.Table != "torrents" || f.Index != 2 || f.ID != torrents[2].ID {
+		t.Errorf("Failed[0] = %v, want torrents[2]", f)
+	}
+	if f := result.Failed[1]; f.Table != "torrent_stats" || f.Index != 2 || !errors.Is(f, ErrTorrentNotSaved) {
+		t.Errorf("Failed[1] = %v, want torrent_stats[2] with ErrTorrentNotSaved", f)
+	}
+
+	// Ostatní řádky dávky jsou commitnuté
+	var saved, savedStats int
+	if err := db.db.QueryRow("SELECT COUNT(*) FROM torrents").Scan(&saved); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.db.QueryRow("SELECT COUNT(*) FROM torrent_stats").Scan(&savedStats); err != nil {
+		t.Fatal(err)
+	}
+	if saved != 4 || savedStats != 4 {
+		t.Errorf("database has %d torrents and %d stats, want 4 and 4", saved, savedStats)
+	}
+	var exists int
+	if err := db.db.QueryRow("SELECT COUNT(*) FROM torrents WHERE id = ?", torrents[2].ID).Scan(&exists); err != nil {
+		t.Fatal(err)
+	}
+	if exists != 0 {
+		t.Errorf("failed torrent %s was saved", torrents[2].ID)
+	}
+}
+
+// benchRows je počet torrentů, které benchmarky ukládají v každé iteraci
+const benchRows = 100_000
+
+// BenchmarkSaveRows ukládá benchRows torrentů po řádcích: UpsertTorrent +
+// RecordTorrentStats, každý dotaz v autocommitu
+func BenchmarkSaveRows(b *testing.B) {
+	ctx := context.Background()
+	torrents, stats := generateTorrents(benchRows)
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		db := newTestDatabase(b)
+		b.StartTimer()
+
+		for j := range torrents {
+			if err := db.UpsertTorrent(ctx, &torrents[j]); err != nil {
+				b.Fatal(err)
+			}
+			if err := db.RecordTorrentStats(ctx, stats[j].TorrentID, stats[j].Seeds, stats[j].Leeches); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+	reportTorrentsPerSecond(b)
+}
+
+// BenchmarkSaveBatch ukládá stejná data přes SaveBatch po batchSize
+// torrentech (transakce s připravenými dotazy)
+func BenchmarkSaveBatch(b *testing.B) {
+	ctx := context.Background()
+	torrents, stats := generateTorrents(benchRows)
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		db := newTestDatabase(b)
+		b.StartTimer()
+
+		for from := 0; from < len(torrents); from += batchSize {
+			to := min(from+batchSize, len(torrents))
+			result, err := db.SaveBatch(ctx, torrents[from:to], stats[from:to])
+			if err != nil {
+				b.Fatal(err)
+			}
+			if len(result.Failed) > 0 {
+				b.Fatalf("failed rows: %v", result.Failed)
+			}
+		}
+	}
+	reportTorrentsPerSecond(b)
+}
+
+func newTestDatabase(tb testing.TB) *Database {
+	tb.Helper()
+	db, err := NewDatabase(filepath.Join(tb.TempDir(), "test.db"))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() { db.Close() })
+	return db
+}
+
+// generateTorrents připraví n torrentů s unikátními ID a jeden stats
+// záznam ke každému
+func generateTorrents(n int) ([]Torrent, []StatsSample) {
+	added := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	torrents := make([]Torrent, n)
+	stats := make([]StatsSample, n)
+	for i := range torrents {
+		sum := sha1.Sum([]byte(strconv.Itoa(i)))
+		id := hex.EncodeToString(sum[:])
+		torrents[i] = Torrent{
+			ID:        id,
+			Name:      fmt.Sprintf("Benchmark Movie %d (2024) 1080p WEB-DL x264 CZ dabing", i),
+			Category:  "Filmy CZ/SK dabing",
+			SizeMB:    float64(700 + i%3000),
+			AddedDate: added.Add(-time.Duration(i) * time.Minute),
+			URL:       "https://sktorrent.eu/torrent/details.php?id=" + id,
+		}
+		stats[i] = StatsSample{TorrentID: id, Seeds: i % 100, Leeches: i % 7}
+	}
+	return torrents, stats
+}
+
+func reportTorrentsPerSecond(b *testing.B) {
+	b.ReportMetric(float64(b.N*benchRows)/b.Elapsed().Seconds(), "torrents/s")
+}
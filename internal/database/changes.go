@@ -0,0 +1,79 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// TorrentChange je jedna změna sledovaného údaje torrentu (torrent_changes)
+type TorrentChange struct {
+	ID        int64
+	TorrentID string
+	Field     string // název sloupce v torrents (name, category, ...)
+	OldValue  string // "" = údaj nebyl vyplněný
+	NewValue  string
+	ChangedAt time.Time
+}
+
+// changeTrackedFields jsou sloupce torrents, jejichž změny se zapisují do
+// torrent_changes
+var changeTrackedFields = []string{"name", "category", "size_mb", "csfd_rating", "csfd_url"}
+
+// torrentChangesSchema zakládá torrent_changes a trigger, který do ní při
+// každém UPDATE torrents (tedy i upsertu) zapíše změněné údaje. Čas změny
+// je updated_at nového řádku, aby odpovídal okamžiku uložení. Trigger se
+// při migraci vždy vytvoří znovu, aby odpovídal aktuálním changeTrackedFields.
+func torrentChangesSchema() []string {
+	trigger := `CREATE TRIGGER torrents_changes AFTER UPDATE ON torrents BEGIN`
+	for _, field := range changeTrackedFields {
+		trigger += fmt.Sprintf(`
+			INSERT INTO torrent_changes (torrent_id, field, old_value, new_value, changed_at)
+			SELECT new.id, '%[1]s', old.%[1]s, new.%[1]s, new.updated_at WHERE old.%[1]s IS NOT new.%[1]s;`, field)
+	}
+	trigger += `
+		END;`
+
+	return []string{
+		`CREATE TABLE IF NOT EXISTS torrent_changes (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			torrent_id TEXT NOT NULL,
+			field TEXT NOT NULL,
+			old_value TEXT,
+			new_value TEXT,
+			changed_at DATETIME NOT NULL,
+			FOREIGN KEY (torrent_id) REFERENCES torrents(id)
+		);`,
+		`CREATE INDEX IF NOT EXISTS idx_changes_torrent ON torrent_changes(torrent_id, changed_at DESC);`,
+		`DROP TRIGGER IF EXISTS torrents_changes;`,
+		trigger,
+	}
+}
+
+// GetTorrentChanges vrátí historii změn torrentu od nejnovější
+func (d *Database) GetTorrentChanges(ctx context.Context, torrentID string, limit int) ([]TorrentChange, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+	SELECT id, torrent_id, field, COALESCE(old_value, ''), COALESCE(new_value, ''), changed_at
+	FROM torrent_changes
+	WHERE torrent_id = ?
+	ORDER BY changed_at DESC, id DESC
+	LIMIT ?`, torrentID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("getting torrent changes: %w", err)
+	}
+	defer rows.Close()
+
+	var changes []TorrentChange
+	for rows.Next() {
+		var c TorrentChange
+		if err := rows.Scan(&c.ID, &c.TorrentID, &c.Field, &c.OldValue, &c.NewValue, &c.ChangedAt); err != nil {
+			return nil, fmt.Errorf("scanning torrent change: %w", err)
+		}
+		changes = append(changes, c)
+	}
+	return changes, rows.Err()
+}
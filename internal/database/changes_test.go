@@ -0,0 +1,54 @@
+package database
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestMigrateReplacesChangesTrigger(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "test.db")
+
+	// Databáze ze starší verze, kde trigger sledoval jen název
+	db, err := NewDatabase(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range []string{
+		`DROP TRIGGER torrents_changes;`,
+		`CREATE TRIGGER torrents_changes AFTER UPDATE ON torrents BEGIN
+			INSERT INTO torrent_changes (torrent_id, field, old_value, new_value, changed_at)
+			SELECT new.id, 'name', old.name, new.name, new.updated_at WHERE old.name IS NOT new.name;
+		END;`,
+	} {
+		if _, err := db.db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db.Close()
+
+	db, err = NewDatabase(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	torrents, _ := generateTorrents(1)
+	torrent := torrents[0]
+	if err := db.UpsertTorrent(ctx, &torrent); err != nil {
+		t.Fatal(err)
+	}
+	torrent.SizeMB += 100
+	if err := db.UpsertTorrent(ctx, &torrent); err != nil {
+		t.Fatal(err)
+	}
+
+	changes, err := db.GetTorrentChanges(ctx, torrent.ID, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].Field != "size_mb" {
+		t.Errorf("changes = %+v, want one size_mb change", changes)
+	}
+}
@@ -0,0 +1,71 @@
+package database
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFinishCrawlRunCaughtUpSkipsPages(t *testing.T) {
+	db := newTestDatabase(t)
+	ctx := context.Background()
+
+	runID, err := db.CreateCrawlRun(ctx, KindIncremental, []int{1, 2, 3, 4, 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, page := range []int{1, 2} {
+		if err := db.RecordCrawlPage(ctx, runID, page, PageDone, 10, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.FinishCrawlRun(ctx, runID, RunCaughtUp, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	pending, err := db.GetPendingPages(ctx, runID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("pending pages = %v, want none", pending)
+	}
+	var skipped int
+	if err := db.db.QueryRow("SELECT COUNT(*) FROM crawl_pages WHERE run_id = ? AND status = ?", runID, PageSkipped).Scan(&skipped); err != nil {
+		t.Fatal(err)
+	}
+	if skipped != 3 {
+		t.Errorf("skipped pages = %d, want 3", skipped)
+	}
+	run, err := db.GetCrawlRun(ctx, runID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if run.Status != RunCaughtUp || run.Torrents != 20 || run.PagesDone != 2 {
+		t.Errorf("run = %+v, want caught_up with 2 pages and 20 torrents", run)
+	}
+}
+
+func TestFinishCrawlRunInterruptedKeepsPending(t *testing.T) {
+	db := newTestDatabase(t)
+	ctx := context.Background()
+
+	runID, err := db.CreateCrawlRun(ctx, KindManual, []int{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.RecordCrawlPage(ctx, runID, 1, PageDone, 10, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.FinishCrawlRun(ctx, runID, RunInterrupted, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	// Přerušený běh musí jít dokončit přes -resume
+	pending, err := db.GetPendingPages(ctx, runID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 2 || pending[0] != 2 || pending[1] != 3 {
+		t.Errorf("pending pages = %v, want [2 3]", pending)
+	}
+}
@@ -1,10 +1,13 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/JaLe29/search-me-plz-sktorrent/internal/release"
 	_ "modernc.org/sqlite"
 )
 
@@ -13,11 +16,13 @@ type Torrent struct {
 	Name       string
 	Category   string
 	SizeMB     float64   // velikost v MB (normalizovaná)
-	AddedDate  time.Time // datum přidání torrentu na web
+	AddedDate  time.Time // datum přidání torrentu na web (nulový čas = NULL, nepodařilo se přečíst)
+	AddedRaw   string    // datum přidání, jak bylo na stránce (added_date_raw)
 	URL        string
 	ImageURL   string
 	CSFDRating int // hodnocení jako číslo (77 místo "77%")
 	CSFDURL    string
+	Release    release.Info // údaje z názvu, dopočítané při ukládání
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 }
@@ -36,12 +41,101 @@ type TorrentWithStats struct {
 	Leeches int
 }
 
+// TorrentDetails jsou metadata z detail stránky torrentu
+type TorrentDetails struct {
+	TorrentID      string
+	Description    string
+	Uploader       string
+	FileCount      int
+	CompletedCount int // počet dokončených stažení
+	IMDbURL        string
+	TrailerURL     string
+	Audio          string
+	Subtitles      string
+	InfoHash       string
+	FetchedAt      time.Time
+}
+
+// KnownTorrent je torrent, který už v databázi je (pro inkrementální crawling)
+type KnownTorrent struct {
+	ID         string
+	AddedDate  time.Time
+	Name       string
+	CSFDRating int
+	CSFDURL    string
+	HasDetails bool // existuje záznam v torrent_details
+}
+
+// FailedPage je stránka výpisu, která selhala i po opakování
+type FailedPage struct {
+	Page     int
+	URL      string
+	Error    string
+	Attempts int // kolik crawlů na stránce selhalo
+	FailedAt time.Time
+}
+
+// Stavy běhů crawleru (crawl_runs.status)
+const (
+	RunRunning     = "running"     // běží, nebo proces spadl (lze navázat přes -resume)
+	RunDone        = "done"        // všechny stránky zpracovány
+	RunCaughtUp    = "caught_up"   // inkrementální běh dohnal známé torrenty
+	RunInterrupted = "interrupted" // přerušeno uživatelem (lze navázat)
+	RunStopped     = "stopped"     // zastaveno kvůli chybám (lze navázat)
+	RunLayout      = "layout"      // sktorrent změnil layout, data ze stránek nejsou spolehlivá
+)
+
+// Druhy běhů crawleru (crawl_runs.kind)
+const (
+	KindManual      = "manual"      // spuštěno ručně nebo z cronu
+	KindIncremental = "incremental" // daemon: nejnovější stránky
+	KindRefresh     = "refresh"     // daemon: obnova stats hlubších stránek
+	KindBackfill    = "backfill"    // daemon: noční procházení starých stránek
+)
+
+// Stavy stránek v rámci běhu (crawl_pages.status)
+const (
+	PagePending = "pending"
+	PageDone    = "done"
+	PageFailed  = "failed"
+	PageSkipped = "skipped" // běh skončil dohnáním dřív, než na stránku došel
+)
+
+// ReleaseFilter omezí výpis torrentů podle údajů z názvu; nil pole se ignorují
+type ReleaseFilter struct {
+	Year       *int
+	Season     *int // torrent obsahuje tuto sérii (i v rozsahu "S01-S03")
+	Episode    *int // torrent obsahuje tento díl (i v rozsahu "E04-E06")
+	Resolution *string
+	Source     *string
+	Codec      *string
+	HDR        *bool
+	Language   *string
+	Dubbed     *bool
+	Group      *string
+}
+
+// CrawlRun je záznam o jednom spuštění crawleru
+type CrawlRun struct {
+	ID         int64
+	Kind       string // KindManual, KindIncremental, ...
+	FromPage   int
+	ToPage     int
+	Status     string
+	StartedAt  time.Time
+	FinishedAt *time.Time
+	Torrents   int // počet zpracovaných torrentů
+	PagesDone  int
+	PagesTotal int
+	Error      string // proč běh selhal (např. popis změny layoutu)
+}
+
 type Database struct {
 	db *sql.DB
 }
 
 func NewDatabase(dbPath string) (*Database, error) {
-	db, err := sql.Open("sqlite", dbPath)
+	db, err := sql.Open("sqlite", withBusyTimeout(dbPath))
 	if err != nil {
 		return nil, fmt.Errorf("opening database: %w", err)
 	}
@@ -58,6 +152,19 @@ func (d *Database) Close() error {
 	return d.db.Close()
 }
 
+// withBusyTimeout nechá zápis počkat na zámek místo chyby "database is locked"
+// (crawler ukládá stránky, zatímco workery čtou známé torrenty)
+func withBusyTimeout(dbPath string) string {
+	if strings.Contains(dbPath, "busy_timeout") {
+		return dbPath
+	}
+	separator := "?"
+	if strings.Contains(dbPath, "?") {
+		separator = "&"
+	}
+	return dbPath + separator + "_pragma=busy_timeout(5000)"
+}
+
 func (d *Database) createTables() error {
 	// Hlavní tabulka torrentů (bez seeds/leeches)
 	torrentSchema := `
@@ -79,6 +186,21 @@ func (d *Database) createTables() error {
 		return fmt.Errorf("creating torrents table: %w", err)
 	}
 
+	// Údaje z názvu torrentu (release.Parse), v existujících databázích
+	// se sloupce doplní a dopočítají
+	if err := d.migrateReleaseColumns(); err != nil {
+		return fmt.Errorf("migrating release columns: %w", err)
+	}
+
+	// Původní text data přidání; NULL added_date s vyplněným textem
+	// znamená, že se datum nepodařilo přečíst
+	if _, err := d.addMissingColumns("torrents", []columnDefinition{{"added_date_raw", "TEXT"}}); err != nil {
+		return fmt.Errorf("migrating added date: %w", err)
+	}
+	if _, err := d.db.Exec(`CREATE INDEX IF NOT EXISTS idx_torrents_added_date ON torrents(added_date);`); err != nil {
+		return fmt.Errorf("creating added date index: %w", err)
+	}
+
 	// Tabulka pro sledování seeds/leeches v čase
 	statsSchema := `
 	CREATE TABLE IF NOT EXISTS torrent_stats (
@@ -108,6 +230,89 @@ func (d *Database) createTables() error {
 		}
 	}
 
+	// Metadata z detail stránky (details.php)
+	detailsSchema := `
+	CREATE TABLE IF NOT EXISTS torrent_details (
+		torrent_id TEXT PRIMARY KEY,
+		description TEXT,
+		uploader TEXT,
+		file_count INTEGER,
+		completed_count INTEGER,
+		imdb_url TEXT,
+		trailer_url TEXT,
+		audio TEXT,
+		subtitles TEXT,
+		info_hash TEXT,
+		fetched_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (torrent_id) REFERENCES torrents(id)
+	);`
+
+	if _, err := d.db.Exec(detailsSchema); err != nil {
+		return fmt.Errorf("creating torrent_details table: %w", err)
+	}
+
+	// Stránky, které selhaly i po opakování (pro -retry-failed)
+	failedPagesSchema := `
+	CREATE TABLE IF NOT EXISTS failed_pages (
+		page INTEGER PRIMARY KEY,
+		url TEXT NOT NULL,
+		error TEXT,
+		attempts INTEGER NOT NULL DEFAULT 1,
+		failed_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);`
+
+	if _, err := d.db.Exec(failedPagesSchema); err != nil {
+		return fmt.Errorf("creating failed_pages table: %w", err)
+	}
+
+	// Historie běhů crawleru a stav jednotlivých stránek (pro -resume)
+	runsSchema := []string{
+		`CREATE TABLE IF NOT EXISTS crawl_runs (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			from_page INTEGER NOT NULL,
+			to_page INTEGER NOT NULL,
+			status TEXT NOT NULL,
+			torrents INTEGER NOT NULL DEFAULT 0,
+			started_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			finished_at DATETIME
+		);`,
+		`CREATE TABLE IF NOT EXISTS crawl_pages (
+			run_id INTEGER NOT NULL,
+			page INTEGER NOT NULL,
+			status TEXT NOT NULL,
+			torrent_count INTEGER NOT NULL DEFAULT 0,
+			error TEXT,
+			fetched_at DATETIME,
+			PRIMARY KEY (run_id, page),
+			FOREIGN KEY (run_id) REFERENCES crawl_runs(id)
+		);`,
+	}
+
+	for _, schema := range runsSchema {
+		if _, err := d.db.Exec(schema); err != nil {
+			return fmt.Errorf("creating crawl runs tables: %w", err)
+		}
+	}
+	if _, err := d.addMissingColumns("crawl_runs", crawlRunsColumns); err != nil {
+		return fmt.Errorf("migrating crawl runs: %w", err)
+	}
+
+	// Stav stránek výpisu pro podmíněné požadavky (ETag, hash obsahu)
+	pageCacheSchema := `
+	CREATE TABLE IF NOT EXISTS page_cache (
+		url TEXT PRIMARY KEY,
+		etag TEXT,
+		last_modified TEXT,
+		body_hash TEXT NOT NULL,
+		torrent_count INTEGER NOT NULL DEFAULT 0,
+		changed_at DATETIME NOT NULL,
+		checked_at DATETIME NOT NULL
+	);`
+
+	if _, err := d.db.Exec(pageCacheSchema); err != nil {
+		return fmt.Errorf("creating page_cache table: %w", err)
+	}
+
 	// FTS5 virtual table pro rychlé vyhledávání (beze změny)
 	ftsSchema := `
 	CREATE VIRTUAL TABLE IF NOT EXISTS torrents_fts USING fts5(
@@ -121,18 +326,25 @@ func (d *Database) createTables() error {
 		return fmt.Errorf("creating FTS table: %w", err)
 	}
 
-	// Triggery pro automatickou synchronizaci FTS
+	// Triggery pro automatickou synchronizaci FTS. Index s content='torrents'
+	// nejde měnit přes UPDATE/DELETE (FTS5 by četla už změněný řádek
+	// a hlásila "database disk image is malformed"), staré hodnoty se
+	// odebírají příkazem 'delete'. Starší verze triggerů se nahradí.
 	triggers := []string{
+		`DROP TRIGGER IF EXISTS torrents_update_fts;`,
+		`DROP TRIGGER IF EXISTS torrents_delete_fts;`,
+
 		`CREATE TRIGGER IF NOT EXISTS torrents_insert_fts AFTER INSERT ON torrents BEGIN
 			INSERT INTO torrents_fts(rowid, name, category) VALUES (new.rowid, new.name, new.category);
 		END;`,
 
-		`CREATE TRIGGER IF NOT EXISTS torrents_update_fts AFTER UPDATE ON torrents BEGIN
-			UPDATE torrents_fts SET name = new.name, category = new.category WHERE rowid = new.rowid;
+		`CREATE TRIGGER torrents_update_fts AFTER UPDATE OF name, category ON torrents BEGIN
+			INSERT INTO torrents_fts(torrents_fts, rowid, name, category) VALUES ('delete', old.rowid, old.name, old.category);
+			INSERT INTO torrents_fts(rowid, name, category) VALUES (new.rowid, new.name, new.category);
 		END;`,
 
-		`CREATE TRIGGER IF NOT EXISTS torrents_delete_fts AFTER DELETE ON torrents BEGIN
-			DELETE FROM torrents_fts WHERE rowid = old.rowid;
+		`CREATE TRIGGER torrents_delete_fts AFTER DELETE ON torrents BEGIN
+			INSERT INTO torrents_fts(torrents_fts, rowid, name, category) VALUES ('delete', old.rowid, old.name, old.category);
 		END;`,
 	}
 
@@ -142,76 +354,661 @@ func (d *Database) createTables() error {
 		}
 	}
 
+	// Historie změn názvu, kategorie, velikosti a ČSFD (plní ji trigger)
+	for _, schema := range torrentChangesSchema() {
+		if _, err := d.db.Exec(schema); err != nil {
+			return fmt.Errorf("creating torrent changes: %w", err)
+		}
+	}
+
 	return nil
 }
 
 // UpsertTorrent vloží nový torrent nebo aktualizuje existující
-func (d *Database) UpsertTorrent(t *Torrent) error {
-	query := `
+func (d *Database) UpsertTorrent(ctx context.Context, t *Torrent) error {
+	return upsertTorrent(ctx, d.db, t)
+}
+
+func upsertTorrent(ctx context.Context, db execer, t *Torrent) error {
+	_, err := db.ExecContext(ctx, upsertTorrentQuery, torrentValues(t, time.Now())...)
+	return err
+}
+
+const upsertTorrentQuery = `
 	INSERT INTO torrents (
-		id, name, category, size_mb, added_date, url,
-		image_url, csfd_rating, csfd_url, created_at, updated_at
-	) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		id, name, category, size_mb, added_date, added_date_raw, url,
+		image_url, csfd_rating, csfd_url, created_at, updated_at,
+		` + releaseColumnList + `
+	) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	ON CONFLICT(id) DO UPDATE SET
 		name = excluded.name,
 		category = excluded.category,
 		size_mb = excluded.size_mb,
-		added_date = excluded.added_date,
+		-- nepřečtené datum nepřepíše dříve uložené
+		added_date = COALESCE(excluded.added_date, torrents.added_date),
+		added_date_raw = CASE WHEN excluded.added_date IS NULL AND torrents.added_date IS NOT NULL
+			THEN torrents.added_date_raw ELSE excluded.added_date_raw END,
 		url = excluded.url,
 		image_url = excluded.image_url,
 		csfd_rating = excluded.csfd_rating,
 		csfd_url = excluded.csfd_url,
-		updated_at = excluded.updated_at
+		updated_at = excluded.updated_at,
+		release_title = excluded.release_title,
+		original_title = excluded.original_title,
+		release_year = excluded.release_year,
+		season = excluded.season,
+		season_to = excluded.season_to,
+		episode_from = excluded.episode_from,
+		episode_to = excluded.episode_to,
+		resolution = excluded.resolution,
+		source = excluded.source,
+		codec = excluded.codec,
+		hdr = excluded.hdr,
+		languages = excluded.languages,
+		dubbed = excluded.dubbed,
+		release_group = excluded.release_group
 	`
 
-	now := time.Now()
+// torrentValues doplní časová razítka a údaje z názvu a vrátí parametry
+// pro upsertTorrentQuery
+func torrentValues(t *Torrent, now time.Time) []interface{} {
 	if t.CreatedAt.IsZero() {
 		t.CreatedAt = now
 	}
 	t.UpdatedAt = now
+	t.Release = release.Parse(t.Name)
 
-	_, err := d.db.Exec(query,
-		t.ID, t.Name, t.Category, t.SizeMB, t.AddedDate, t.URL,
+	args := []interface{}{
+		t.ID, t.Name, t.Category, t.SizeMB, nullTime(t.AddedDate), nullString(t.AddedRaw), t.URL,
 		t.ImageURL, t.CSFDRating, t.CSFDURL,
 		t.CreatedAt, t.UpdatedAt,
+	}
+	return append(args, releaseValues(t.Release)...)
+}
+
+// crawlRunsColumns jsou sloupce crawl_runs přidané po jejím vzniku
+var crawlRunsColumns = []columnDefinition{
+	{"error", "TEXT"},
+	{"kind", "TEXT NOT NULL DEFAULT '" + KindManual + "'"},
+}
+
+// releaseColumnList jsou sloupce s údaji z názvu ve stejném pořadí jako releaseValues
+const releaseColumnList = `release_title, original_title, release_year, season, season_to,
+		episode_from, episode_to, resolution, source, codec, hdr, languages, dubbed, release_group`
+
+// releaseColumns jsou definice sloupců pro migraci existujících databází
+var releaseColumns = []columnDefinition{
+	{"release_title", "TEXT NOT NULL DEFAULT ''"},
+	{"original_title", "TEXT NOT NULL DEFAULT ''"},
+	{"release_year", "INTEGER NOT NULL DEFAULT 0"},
+	{"season", "INTEGER NOT NULL DEFAULT 0"},
+	{"season_to", "INTEGER NOT NULL DEFAULT 0"},
+	{"episode_from", "INTEGER NOT NULL DEFAULT 0"},
+	{"episode_to", "INTEGER NOT NULL DEFAULT 0"},
+	{"resolution", "TEXT NOT NULL DEFAULT ''"},
+	{"source", "TEXT NOT NULL DEFAULT ''"},
+	{"codec", "TEXT NOT NULL DEFAULT ''"},
+	{"hdr", "TEXT NOT NULL DEFAULT ''"},
+	{"languages", "TEXT NOT NULL DEFAULT ''"}, // "CZ,EN"
+	{"dubbed", "BOOLEAN NOT NULL DEFAULT 0"},
+	{"release_group", "TEXT NOT NULL DEFAULT ''"},
+}
+
+// nullTime uloží nulový čas jako NULL a ostatní v UTC, aby se data řadila
+// správně i jako text
+func nullTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t.UTC()
+}
+
+func nullString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func releaseValues(r release.Info) []interface{} {
+	return []interface{}{
+		r.Title, r.OriginalTitle, r.Year, r.Season, r.SeasonTo,
+		r.EpisodeFrom, r.EpisodeTo, r.Resolution, r.Source, r.Codec, r.HDR,
+		strings.Join(r.Languages, ","), r.Dubbed, r.Group,
+	}
+}
+
+// addMissingColumns přidá do tabulky sloupce, které v ní ještě nejsou.
+// Vrací true, pokud nějaký přibyl.
+func (d *Database) addMissingColumns(table string, columns []columnDefinition) (bool, error) {
+	rows, err := d.db.Query("SELECT name FROM pragma_table_info(?)", table)
+	if err != nil {
+		return false, err
+	}
+	existing := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return false, err
+		}
+		existing[name] = true
+	}
+	rows.Close()
+
+	added := false
+	for _, col := range columns {
+		if existing[col.name] {
+			continue
+		}
+		if _, err := d.db.Exec("ALTER TABLE " + table + " ADD COLUMN " + col.name + " " + col.definition); err != nil {
+			return false, fmt.Errorf("adding column %s.%s: %w", table, col.name, err)
+		}
+		added = true
+	}
+	return added, nil
+}
+
+// columnDefinition je sloupec přidávaný migrací do existující tabulky
+type columnDefinition struct{ name, definition string }
+
+// migrateReleaseColumns doplní chybějící sloupce s údaji z názvu a pokud
+// nějaký přibyl, dopočítá je pro všechny uložené torrenty
+func (d *Database) migrateReleaseColumns() error {
+	added, err := d.addMissingColumns("torrents", releaseColumns)
+	if err != nil {
+		return err
+	}
+
+	indexes := []string{
+		`CREATE INDEX IF NOT EXISTS idx_torrents_release_year ON torrents(release_year);`,
+		`CREATE INDEX IF NOT EXISTS idx_torrents_resolution ON torrents(resolution);`,
+	}
+	for _, indexSQL := range indexes {
+		if _, err := d.db.Exec(indexSQL); err != nil {
+			return fmt.Errorf("creating release index: %w", err)
+		}
+	}
+
+	if added {
+		if _, err := d.ReparseReleases(context.Background()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReparseReleases znovu rozebere názvy všech torrentů a přepíše údaje
+// z názvu (po migraci nebo po úpravě parseru). Vrací počet torrentů.
+func (d *Database) ReparseReleases(ctx context.Context) (int, error) {
+	rows, err := d.db.QueryContext(ctx, "SELECT id, name FROM torrents")
+	if err != nil {
+		return 0, fmt.Errorf("getting torrent names: %w", err)
+	}
+	names := make(map[string]string)
+	for rows.Next() {
+		var id, name string
+		if err := rows.Scan(&id, &name); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("scanning torrent name: %w", err)
+		}
+		names[id] = name
+	}
+	rows.Close()
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, `
+	UPDATE torrents SET
+		release_title = ?, original_title = ?, release_year = ?, season = ?, season_to = ?,
+		episode_from = ?, episode_to = ?, resolution = ?, source = ?, codec = ?, hdr = ?,
+		languages = ?, dubbed = ?, release_group = ?
+	WHERE id = ?`)
+	if err != nil {
+		return 0, fmt.Errorf("preparing release update: %w", err)
+	}
+	defer stmt.Close()
+
+	for id, name := range names {
+		args := append(releaseValues(release.Parse(name)), id)
+		if _, err := stmt.ExecContext(ctx, args...); err != nil {
+			return 0, fmt.Errorf("updating release info of %s: %w", id, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("committing release update: %w", err)
+	}
+	return len(names), nil
+}
+
+// RecordTorrentStats zaznamená aktuální seeds/leeches pro torrent, pokud
+// se od posledního záznamu změnily
+func (d *Database) RecordTorrentStats(ctx context.Context, torrentID string, seeds, leeches int) error {
+	return recordTorrentStats(ctx, d.db, torrentID, seeds, leeches)
+}
+
+func recordTorrentStats(ctx context.Context, db execer, torrentID string, seeds, leeches int) error {
+	_, err := db.ExecContext(ctx, insertStatsQuery, torrentID, seeds, leeches, time.Now())
+	return err
+}
+
+// insertStatsQuery zapíše seeds/leeches jen tehdy, když se liší od
+// posledního záznamu torrentu; beze změny nevloží nic (RowsAffected = 0)
+const insertStatsQuery = `
+	INSERT INTO torrent_stats (torrent_id, seeds, leeches, recorded_at)
+	SELECT ?1, ?2, ?3, ?4
+	WHERE NOT EXISTS (
+		SELECT 1 FROM (
+			SELECT seeds, leeches FROM torrent_stats
+			WHERE torrent_id = ?1
+			ORDER BY recorded_at DESC
+			LIMIT 1
+		) WHERE seeds = ?2 AND leeches = ?3
 	)
+	`
+
+// UpsertTorrentDetails uloží metadata z detail stránky torrentu
+func (d *Database) UpsertTorrentDetails(ctx context.Context, t *TorrentDetails) error {
+	return upsertTorrentDetails(ctx, d.db, t)
+}
+
+func upsertTorrentDetails(ctx context.Context, db execer, t *TorrentDetails) error {
+	query := `
+	INSERT INTO torrent_details (
+		torrent_id, description, uploader, file_count, completed_count,
+		imdb_url, trailer_url, audio, subtitles, info_hash, fetched_at
+	) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	ON CONFLICT(torrent_id) DO UPDATE SET
+		description = excluded.description,
+		uploader = excluded.uploader,
+		file_count = excluded.file_count,
+		completed_count = excluded.completed_count,
+		imdb_url = excluded.imdb_url,
+		trailer_url = excluded.trailer_url,
+		audio = excluded.audio,
+		subtitles = excluded.subtitles,
+		info_hash = excluded.info_hash,
+		fetched_at = excluded.fetched_at
+	`
 
+	if t.FetchedAt.IsZero() {
+		t.FetchedAt = time.Now()
+	}
+
+	_, err := db.ExecContext(ctx, query,
+		t.TorrentID, t.Description, t.Uploader, t.FileCount, t.CompletedCount,
+		t.IMDbURL, t.TrailerURL, t.Audio, t.Subtitles, t.InfoHash, t.FetchedAt,
+	)
 	return err
 }
 
-// RecordTorrentStats zaznamená aktuální seeds/leeches pro torrent
-func (d *Database) RecordTorrentStats(torrentID string, seeds, leeches int) error {
+// GetTorrentDetails vrátí metadata z detail stránky, nebo nil, pokud je ještě nemáme
+func (d *Database) GetTorrentDetails(ctx context.Context, torrentID string) (*TorrentDetails, error) {
 	query := `
-	INSERT INTO torrent_stats (torrent_id, seeds, leeches, recorded_at)
-	VALUES (?, ?, ?, ?)
+	SELECT torrent_id, COALESCE(description, ''), COALESCE(uploader, ''),
+		   COALESCE(file_count, 0), COALESCE(completed_count, 0),
+		   COALESCE(imdb_url, ''), COALESCE(trailer_url, ''), COALESCE(audio, ''),
+		   COALESCE(subtitles, ''), COALESCE(info_hash, ''), fetched_at
+	FROM torrent_details
+	WHERE torrent_id = ?
+	`
+
+	var t TorrentDetails
+	err := d.db.QueryRowContext(ctx, query, torrentID).Scan(
+		&t.TorrentID, &t.Description, &t.Uploader, &t.FileCount, &t.CompletedCount,
+		&t.IMDbURL, &t.TrailerURL, &t.Audio, &t.Subtitles, &t.InfoHash, &t.FetchedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting torrent details: %w", err)
+	}
+
+	return &t, nil
+}
+
+// GetKnownTorrents vrátí z ids ty torrenty, které už databáze zná, jedním
+// dotazem (pro inkrementální crawling a přeskakování detail stránek)
+func (d *Database) GetKnownTorrents(ctx context.Context, ids []string) (map[string]KnownTorrent, error) {
+	known := make(map[string]KnownTorrent, len(ids))
+	if len(ids) == 0 {
+		return known, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	query := `
+	SELECT t.id, t.added_date, t.name, COALESCE(t.csfd_rating, 0), COALESCE(t.csfd_url, ''),
+		   d.torrent_id IS NOT NULL
+	FROM torrents t
+	LEFT JOIN torrent_details d ON d.torrent_id = t.id
+	WHERE t.id IN (` + placeholders + `)`
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("getting known torrents: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var k KnownTorrent
+		var addedDate sql.NullTime
+		if err := rows.Scan(&k.ID, &addedDate, &k.Name, &k.CSFDRating, &k.CSFDURL, &k.HasDetails); err != nil {
+			return nil, fmt.Errorf("scanning known torrent: %w", err)
+		}
+		k.AddedDate = addedDate.Time
+		known[k.ID] = k
+	}
+
+	return known, rows.Err()
+}
+
+// MarkPageFailed zapíše stránku do seznamu neúspěšných (nebo zvýší počet pokusů)
+func (d *Database) MarkPageFailed(ctx context.Context, page int, url, errMsg string) error {
+	return markPageFailed(ctx, d.db, page, url, errMsg)
+}
+
+func markPageFailed(ctx context.Context, db execer, page int, url, errMsg string) error {
+	query := `
+	INSERT INTO failed_pages (page, url, error, attempts, failed_at)
+	VALUES (?, ?, ?, 1, ?)
+	ON CONFLICT(page) DO UPDATE SET
+		url = excluded.url,
+		error = excluded.error,
+		attempts = failed_pages.attempts + 1,
+		failed_at = excluded.failed_at
 	`
 
-	_, err := d.db.Exec(query, torrentID, seeds, leeches, time.Now())
+	_, err := db.ExecContext(ctx, query, page, url, errMsg, time.Now())
 	return err
 }
 
-// GetTorrentWithCurrentStats vrátí torrent s nejnovějšími stats
-func (d *Database) GetTorrentWithCurrentStats(torrentID string) (*TorrentWithStats, error) {
+// ClearFailedPage odstraní stránku ze seznamu neúspěšných
+func (d *Database) ClearFailedPage(ctx context.Context, page int) error {
+	return clearFailedPage(ctx, d.db, page)
+}
+
+func clearFailedPage(ctx context.Context, db execer, page int) error {
+	_, err := db.ExecContext(ctx, "DELETE FROM failed_pages WHERE page = ?", page)
+	return err
+}
+
+// GetFailedPages vrátí všechny neúspěšné stránky seřazené podle čísla stránky
+func (d *Database) GetFailedPages(ctx context.Context) ([]FailedPage, error) {
+	rows, err := d.db.QueryContext(ctx, `
+	SELECT page, url, COALESCE(error, ''), attempts, failed_at
+	FROM failed_pages
+	ORDER BY page
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("getting failed pages: %w", err)
+	}
+	defer rows.Close()
+
+	var pages []FailedPage
+	for rows.Next() {
+		var p FailedPage
+		if err := rows.Scan(&p.Page, &p.URL, &p.Error, &p.Attempts, &p.FailedAt); err != nil {
+			return nil, fmt.Errorf("scanning failed page: %w", err)
+		}
+		pages = append(pages, p)
+	}
+
+	return pages, rows.Err()
+}
+
+// CreateCrawlRun založí nový běh daného druhu a všechny jeho stránky jako
+// pending. Prázdný kind je KindManual.
+func (d *Database) CreateCrawlRun(ctx context.Context, kind string, pages []int) (int64, error) {
+	if len(pages) == 0 {
+		return 0, fmt.Errorf("creating crawl run: no pages")
+	}
+	if kind == "" {
+		kind = KindManual
+	}
+	from, to := pages[0], pages[0]
+	for _, p := range pages {
+		from = min(from, p)
+		to = max(to, p)
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx,
+		"INSERT INTO crawl_runs (kind, from_page, to_page, status, started_at) VALUES (?, ?, ?, ?, ?)",
+		kind, from, to, RunRunning, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("creating crawl run: %w", err)
+	}
+	runID, err := res.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("getting crawl run id: %w", err)
+	}
+
+	stmt, err := tx.PrepareContext(ctx, "INSERT OR IGNORE INTO crawl_pages (run_id, page, status) VALUES (?, ?, ?)")
+	if err != nil {
+		return 0, fmt.Errorf("preparing crawl pages insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, p := range pages {
+		if _, err := stmt.ExecContext(ctx, runID, p, PagePending); err != nil {
+			return 0, fmt.Errorf("creating crawl page %d: %w", p, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("committing crawl run: %w", err)
+	}
+	return runID, nil
+}
+
+// RecordCrawlPage zapíše výsledek stránky v rámci běhu
+func (d *Database) RecordCrawlPage(ctx context.Context, runID int64, page int, status string, torrentCount int, errMsg string) error {
+	return recordCrawlPage(ctx, d.db, runID, page, status, torrentCount, errMsg)
+}
+
+func recordCrawlPage(ctx context.Context, db execer, runID int64, page int, status string, torrentCount int, errMsg string) error {
+	query := `
+	INSERT INTO crawl_pages (run_id, page, status, torrent_count, error, fetched_at)
+	VALUES (?, ?, ?, ?, NULLIF(?, ''), ?)
+	ON CONFLICT(run_id, page) DO UPDATE SET
+		status = excluded.status,
+		torrent_count = excluded.torrent_count,
+		error = excluded.error,
+		fetched_at = excluded.fetched_at
+	`
+
+	_, err := db.ExecContext(ctx, query, runID, page, status, torrentCount, errMsg, time.Now())
+	return err
+}
+
+// ReopenCrawlRun označí nedokončený běh znovu jako běžící (pro -resume)
+func (d *Database) ReopenCrawlRun(ctx context.Context, runID int64) error {
+	_, err := d.db.ExecContext(ctx,
+		"UPDATE crawl_runs SET status = ?, error = NULL, finished_at = NULL WHERE id = ?", RunRunning, runID)
+	return err
+}
+
+// FinishCrawlRun uloží konečný stav běhu; errMsg vysvětlí neúspěšný běh.
+// Dohnaný běh (RunCaughtUp) označí stránky, na které nedošel, jako
+// PageSkipped, aby v crawl_pages nezůstávaly pending.
+func (d *Database) FinishCrawlRun(ctx context.Context, runID int64, status, errMsg string) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if status == RunCaughtUp {
+		if _, err := tx.ExecContext(ctx,
+			"UPDATE crawl_pages SET status = ? WHERE run_id = ? AND status = ?", PageSkipped, runID, PagePending); err != nil {
+			return fmt.Errorf("skipping unreached pages: %w", err)
+		}
+	}
+
 	query := `
-	SELECT t.id, t.name, t.category, t.size_mb, t.added_date, t.url, t.image_url,
+	UPDATE crawl_runs SET
+		status = ?,
+		error = NULLIF(?, ''),
+		finished_at = ?,
+		torrents = (SELECT COALESCE(SUM(torrent_count), 0) FROM crawl_pages WHERE run_id = ?)
+	WHERE id = ?
+	`
+
+	if _, err := tx.ExecContext(ctx, query, status, errMsg, time.Now(), runID, runID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetPendingPages vrátí stránky běhu, které ještě nejsou hotové ani přeskočené
+func (d *Database) GetPendingPages(ctx context.Context, runID int64) ([]int, error) {
+	rows, err := d.db.QueryContext(ctx,
+		"SELECT page FROM crawl_pages WHERE run_id = ? AND status NOT IN (?, ?) ORDER BY page", runID, PageDone, PageSkipped)
+	if err != nil {
+		return nil, fmt.Errorf("getting pending pages: %w", err)
+	}
+	defer rows.Close()
+
+	var pages []int
+	for rows.Next() {
+		var p int
+		if err := rows.Scan(&p); err != nil {
+			return nil, fmt.Errorf("scanning pending page: %w", err)
+		}
+		pages = append(pages, p)
+	}
+
+	return pages, rows.Err()
+}
+
+const crawlRunColumns = `
+	SELECT r.id, r.kind, r.from_page, r.to_page, r.status, r.started_at, r.finished_at, r.torrents, COALESCE(r.error, ''),
+		   (SELECT COUNT(*) FROM crawl_pages p WHERE p.run_id = r.id AND p.status = 'done'),
+		   (SELECT COUNT(*) FROM crawl_pages p WHERE p.run_id = r.id)
+	FROM crawl_runs r
+`
+
+// GetCrawlRun vrátí jeden běh crawleru
+func (d *Database) GetCrawlRun(ctx context.Context, runID int64) (*CrawlRun, error) {
+	row := d.db.QueryRowContext(ctx, crawlRunColumns+" WHERE r.id = ?", runID)
+
+	run, err := scanCrawlRun(row)
+	if err != nil {
+		return nil, fmt.Errorf("getting crawl run %d: %w", runID, err)
+	}
+	return run, nil
+}
+
+// GetCrawlRuns vrátí nejnovější běhy crawleru
+func (d *Database) GetCrawlRuns(ctx context.Context, limit int) ([]CrawlRun, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+
+	rows, err := d.db.QueryContext(ctx, crawlRunColumns+" ORDER BY r.id DESC LIMIT ?", limit)
+	if err != nil {
+		return nil, fmt.Errorf("getting crawl runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []CrawlRun
+	for rows.Next() {
+		run, err := scanCrawlRun(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning crawl run: %w", err)
+		}
+		runs = append(runs, *run)
+	}
+
+	return runs, rows.Err()
+}
+
+// LatestCrawlRun vrátí nejnovější běh daného druhu, nebo nil, když žádný není
+func (d *Database) LatestCrawlRun(ctx context.Context, kind string) (*CrawlRun, error) {
+	row := d.db.QueryRowContext(ctx, crawlRunColumns+" WHERE r.kind = ? ORDER BY r.id DESC LIMIT 1", kind)
+
+	run, err := scanCrawlRun(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting latest %s run: %w", kind, err)
+	}
+	return run, nil
+}
+
+func scanCrawlRun(row interface{ Scan(...interface{}) error }) (*CrawlRun, error) {
+	var run CrawlRun
+	var finishedAt sql.NullTime
+	err := row.Scan(&run.ID, &run.Kind, &run.FromPage, &run.ToPage, &run.Status, &run.StartedAt,
+		&finishedAt, &run.Torrents, &run.Error, &run.PagesDone, &run.PagesTotal)
+	if err != nil {
+		return nil, err
+	}
+	if finishedAt.Valid {
+		run.FinishedAt = &finishedAt.Time
+	}
+	return &run, nil
+}
+
+// torrentWithStatsQuery je společný začátek dotazů na torrenty s nejnovějšími
+// stats; čte se přes scanTorrentWithStats
+const torrentWithStatsQuery = `
+	SELECT t.id, t.name, t.category, t.size_mb, t.added_date, COALESCE(t.added_date_raw, ''), t.url, t.image_url,
 		   t.csfd_rating, t.csfd_url, t.created_at, t.updated_at,
+		   ` + releaseColumnList + `,
 		   COALESCE(s.seeds, 0) as seeds, COALESCE(s.leeches, 0) as leeches
 	FROM torrents t
 	LEFT JOIN (
 		SELECT torrent_id, seeds, leeches,
 			   ROW_NUMBER() OVER (PARTITION BY torrent_id ORDER BY recorded_at DESC) as rn
 		FROM torrent_stats
-	) s ON t.id = s.torrent_id AND s.rn = 1
+	) s ON t.id = s.torrent_id AND s.rn = 1`
+
+func scanTorrentWithStats(row interface{ Scan(...interface{}) error }) (TorrentWithStats, error) {
+	var t TorrentWithStats
+	var languages string
+	var addedDate sql.NullTime
+	r := &t.Release
+	err := row.Scan(
+		&t.ID, &t.Name, &t.Category, &t.SizeMB, &addedDate, &t.AddedRaw,
+		&t.URL, &t.ImageURL, &t.CSFDRating, &t.CSFDURL,
+		&t.CreatedAt, &t.UpdatedAt,
+		&r.Title, &r.OriginalTitle, &r.Year, &r.Season, &r.SeasonTo,
+		&r.EpisodeFrom, &r.EpisodeTo, &r.Resolution, &r.Source, &r.Codec, &r.HDR,
+		&languages, &r.Dubbed, &r.Group,
+		&t.Seeds, &t.Leeches,
+	)
+	if languages != "" {
+		r.Languages = strings.Split(languages, ",")
+	}
+	t.AddedDate = addedDate.Time
+	return t, err
+}
+
+// GetTorrentWithCurrentStats vrátí torrent s nejnovějšími stats
+func (d *Database) GetTorrentWithCurrentStats(torrentID string) (*TorrentWithStats, error) {
+	query := torrentWithStatsQuery + `
 	WHERE t.id = ?
 	`
 
-	var result TorrentWithStats
-	err := d.db.QueryRow(query, torrentID).Scan(
-		&result.ID, &result.Name, &result.Category, &result.SizeMB, &result.AddedDate,
-		&result.URL, &result.ImageURL, &result.CSFDRating, &result.CSFDURL,
-		&result.CreatedAt, &result.UpdatedAt, &result.Seeds, &result.Leeches,
-	)
-
+	result, err := scanTorrentWithStats(d.db.QueryRow(query, torrentID))
 	if err != nil {
 		return nil, fmt.Errorf("getting torrent with stats: %w", err)
 	}
@@ -225,16 +1022,7 @@ func (d *Database) SearchTorrents(query string, limit int) ([]TorrentWithStats,
 		limit = 50
 	}
 
-	sqlQuery := `
-	SELECT t.id, t.name, t.category, t.size_mb, t.added_date, t.url, t.image_url,
-		   t.csfd_rating, t.csfd_url, t.created_at, t.updated_at,
-		   COALESCE(s.seeds, 0) as seeds, COALESCE(s.leeches, 0) as leeches
-	FROM torrents t
-	LEFT JOIN (
-		SELECT torrent_id, seeds, leeches,
-			   ROW_NUMBER() OVER (PARTITION BY torrent_id ORDER BY recorded_at DESC) as rn
-		FROM torrent_stats
-	) s ON t.id = s.torrent_id AND s.rn = 1
+	sqlQuery := torrentWithStatsQuery + `
 	WHERE (t.name LIKE ? OR t.category LIKE ?)
 	ORDER BY t.updated_at DESC
 	LIMIT ?
@@ -249,12 +1037,7 @@ func (d *Database) SearchTorrents(query string, limit int) ([]TorrentWithStats,
 
 	var torrents []TorrentWithStats
 	for rows.Next() {
-		var t TorrentWithStats
-		err := rows.Scan(
-			&t.ID, &t.Name, &t.Category, &t.SizeMB, &t.AddedDate,
-			&t.URL, &t.ImageURL, &t.CSFDRating, &t.CSFDURL,
-			&t.CreatedAt, &t.UpdatedAt, &t.Seeds, &t.Leeches,
-		)
+		t, err := scanTorrentWithStats(rows)
 		if err != nil {
 			return nil, fmt.Errorf("scanning torrent: %w", err)
 		}
@@ -270,16 +1053,7 @@ func (d *Database) GetTorrentsByCategory(category string, limit int) ([]TorrentW
 		limit = 50
 	}
 
-	query := `
-	SELECT t.id, t.name, t.category, t.size_mb, t.added_date, t.url, t.image_url,
-		   t.csfd_rating, t.csfd_url, t.created_at, t.updated_at,
-		   COALESCE(s.seeds, 0) as seeds, COALESCE(s.leeches, 0) as leeches
-	FROM torrents t
-	LEFT JOIN (
-		SELECT torrent_id, seeds, leeches,
-			   ROW_NUMBER() OVER (PARTITION BY torrent_id ORDER BY recorded_at DESC) as rn
-		FROM torrent_stats
-	) s ON t.id = s.torrent_id AND s.rn = 1
+	query := torrentWithStatsQuery + `
 	WHERE t.category = ?
 	ORDER BY t.updated_at DESC
 	LIMIT ?
@@ -293,12 +1067,7 @@ func (d *Database) GetTorrentsByCategory(category string, limit int) ([]TorrentW
 
 	var torrents []TorrentWithStats
 	for rows.Next() {
-		var t TorrentWithStats
-		err := rows.Scan(
-			&t.ID, &t.Name, &t.Category, &t.SizeMB, &t.AddedDate,
-			&t.URL, &t.ImageURL, &t.CSFDRating, &t.CSFDURL,
-			&t.CreatedAt, &t.UpdatedAt, &t.Seeds, &t.Leeches,
-		)
+		t, err := scanTorrentWithStats(rows)
 		if err != nil {
 			return nil, fmt.Errorf("scanning torrent: %w", err)
 		}
@@ -314,16 +1083,7 @@ func (d *Database) GetRecentTorrents(limit int) ([]TorrentWithStats, error) {
 		limit = 50
 	}
 
-	query := `
-	SELECT t.id, t.name, t.category, t.size_mb, t.added_date, t.url, t.image_url,
-		   t.csfd_rating, t.csfd_url, t.created_at, t.updated_at,
-		   COALESCE(s.seeds, 0) as seeds, COALESCE(s.leeches, 0) as leeches
-	FROM torrents t
-	LEFT JOIN (
-		SELECT torrent_id, seeds, leeches,
-			   ROW_NUMBER() OVER (PARTITION BY torrent_id ORDER BY recorded_at DESC) as rn
-		FROM torrent_stats
-	) s ON t.id = s.torrent_id AND s.rn = 1
+	query := torrentWithStatsQuery + `
 	ORDER BY t.updated_at DESC
 	LIMIT ?
 	`
@@ -336,12 +1096,7 @@ func (d *Database) GetRecentTorrents(limit int) ([]TorrentWithStats, error) {
 
 	var torrents []TorrentWithStats
 	for rows.Next() {
-		var t TorrentWithStats
-		err := rows.Scan(
-			&t.ID, &t.Name, &t.Category, &t.SizeMB, &t.AddedDate,
-			&t.URL, &t.ImageURL, &t.CSFDRating, &t.CSFDURL,
-			&t.CreatedAt, &t.UpdatedAt, &t.Seeds, &t.Leeches,
-		)
+		t, err := scanTorrentWithStats(rows)
 		if err != nil {
 			return nil, fmt.Errorf("scanning torrent: %w", err)
 		}
@@ -428,16 +1183,7 @@ func (d *Database) GetTorrentsByCSFDID(csfdID string, limit int) ([]TorrentWithS
 		limit = 50
 	}
 
-	query := `
-	SELECT t.id, t.name, t.category, t.size_mb, t.added_date, t.url, t.image_url,
-		   t.csfd_rating, t.csfd_url, t.created_at, t.updated_at,
-		   COALESCE(s.seeds, 0) as seeds, COALESCE(s.leeches, 0) as leeches
-	FROM torrents t
-	LEFT JOIN (
-		SELECT torrent_id, seeds, leeches,
-			   ROW_NUMBER() OVER (PARTITION BY torrent_id ORDER BY recorded_at DESC) as rn
-		FROM torrent_stats
-	) s ON t.id = s.torrent_id AND s.rn = 1
+	query := torrentWithStatsQuery + `
 	WHERE t.csfd_url LIKE ?
 	ORDER BY t.updated_at DESC
 	LIMIT ?
@@ -451,12 +1197,7 @@ func (d *Database) GetTorrentsByCSFDID(csfdID string, limit int) ([]TorrentWithS
 
 	var torrents []TorrentWithStats
 	for rows.Next() {
-		var t TorrentWithStats
-		err := rows.Scan(
-			&t.ID, &t.Name, &t.Category, &t.SizeMB, &t.AddedDate,
-			&t.URL, &t.ImageURL, &t.CSFDRating, &t.CSFDURL,
-			&t.CreatedAt, &t.UpdatedAt, &t.Seeds, &t.Leeches,
-		)
+		t, err := scanTorrentWithStats(rows)
 		if err != nil {
 			return nil, fmt.Errorf("scanning torrent: %w", err)
 		}
@@ -467,7 +1208,7 @@ func (d *Database) GetTorrentsByCSFDID(csfdID string, limit int) ([]TorrentWithS
 }
 
 // GetTorrentsWithPagination vrátí torrenty s stránkováním a informací o dalších stránkách
-func (d *Database) GetTorrentsWithPagination(offset, limit int, category *string, search *string, sortBy string) ([]TorrentWithStats, int, bool, error) {
+func (d *Database) GetTorrentsWithPagination(offset, limit int, category *string, search *string, sortBy string, filter ReleaseFilter) ([]TorrentWithStats, int, bool, error) {
 	if limit <= 0 {
 		limit = 20
 	}
@@ -476,37 +1217,36 @@ func (d *Database) GetTorrentsWithPagination(offset, limit int, category *string
 	}
 
 	// Base query
-	baseQuery := `
-	SELECT t.id, t.name, t.category, t.size_mb, t.added_date, t.url, t.image_url,
-		   t.csfd_rating, t.csfd_url, t.created_at, t.updated_at,
-		   COALESCE(s.seeds, 0) as seeds, COALESCE(s.leeches, 0) as leeches
-	FROM torrents t
-	LEFT JOIN (
-		SELECT torrent_id, seeds, leeches,
-			   ROW_NUMBER() OVER (PARTITION BY torrent_id ORDER BY recorded_at DESC) as rn
-		FROM torrent_stats
-	) s ON t.id = s.torrent_id AND s.rn = 1
-	`
+	baseQuery := torrentWithStatsQuery
 
 	// Build WHERE clause
-	var whereClause string
+	var conditions []string
 	var args []interface{}
 
 	if search != nil && *search != "" {
 		// Use LIKE for contains search instead of FTS5 for better partial matching
-		whereClause = "WHERE (t.name LIKE ? OR t.category LIKE ?)"
+		conditions = append(conditions, "(t.name LIKE ? OR t.category LIKE ?)")
 		searchTerm := "%" + *search + "%"
 		args = append(args, searchTerm, searchTerm)
 	} else if category != nil && *category != "" {
-		whereClause = "WHERE t.category = ?"
+		conditions = append(conditions, "t.category = ?")
 		args = append(args, *category)
 	}
 
+	releaseConditions, releaseArgs := filter.conditions()
+	conditions = append(conditions, releaseConditions...)
+	args = append(args, releaseArgs...)
+
+	var whereClause string
+	if len(conditions) > 0 {
+		whereClause = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
 	// Build ORDER BY clause
 	var orderBy string
 	switch sortBy {
 	case "OLDEST":
-		orderBy = "ORDER BY t.updated_at ASC"
+		orderBy = "ORDER BY t.added_date IS NULL, t.added_date ASC, t.updated_at ASC"
 	case "NAME_ASC":
 		orderBy = "ORDER BY t.name ASC"
 	case "NAME_DESC":
@@ -520,7 +1260,7 @@ func (d *Database) GetTorrentsWithPagination(offset, limit int, category *string
 	case "LEECHES_DESC":
 		orderBy = "ORDER BY s.leeches DESC"
 	default: // NEWEST
-		orderBy = "ORDER BY t.updated_at DESC"
+		orderBy = "ORDER BY t.added_date IS NULL, t.added_date DESC, t.updated_at DESC"
 	}
 
 	// Count total results for hasNextPage
@@ -553,12 +1293,7 @@ func (d *Database) GetTorrentsWithPagination(offset, limit int, category *string
 
 	var torrents []TorrentWithStats
 	for rows.Next() {
-		var t TorrentWithStats
-		err := rows.Scan(
-			&t.ID, &t.Name, &t.Category, &t.SizeMB, &t.AddedDate,
-			&t.URL, &t.ImageURL, &t.CSFDRating, &t.CSFDURL,
-			&t.CreatedAt, &t.UpdatedAt, &t.Seeds, &t.Leeches,
-		)
+		t, err := scanTorrentWithStats(rows)
 		if err != nil {
 			return nil, 0, false, fmt.Errorf("scanning torrent: %w", err)
 		}
@@ -573,3 +1308,50 @@ func (d *Database) GetTorrentsWithPagination(offset, limit int, category *string
 
 	return torrents, totalCount, hasNextPage, nil
 }
+
+// conditions převede filtr na podmínky pro WHERE nad tabulkou torrents (alias t)
+func (f ReleaseFilter) conditions() ([]string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+	add := func(condition string, values ...interface{}) {
+		conditions = append(conditions, condition)
+		args = append(args, values...)
+	}
+
+	if f.Year != nil {
+		add("t.release_year = ?", *f.Year)
+	}
+	if f.Season != nil {
+		add("? BETWEEN t.season AND t.season_to", *f.Season)
+	}
+	if f.Episode != nil {
+		add("? BETWEEN t.episode_from AND t.episode_to", *f.Episode)
+	}
+	if f.Resolution != nil && *f.Resolution != "" {
+		add("t.resolution = ? COLLATE NOCASE", *f.Resolution)
+	}
+	if f.Source != nil && *f.Source != "" {
+		add("t.source = ? COLLATE NOCASE", *f.Source)
+	}
+	if f.Codec != nil && *f.Codec != "" {
+		add("t.codec = ? COLLATE NOCASE", *f.Codec)
+	}
+	if f.HDR != nil {
+		if *f.HDR {
+			add("t.hdr != ''")
+		} else {
+			add("t.hdr = ''")
+		}
+	}
+	if f.Language != nil && *f.Language != "" {
+		add("(',' || t.languages || ',') LIKE ?", "%,"+strings.ToUpper(*f.Language)+",%")
+	}
+	if f.Dubbed != nil {
+		add("t.dubbed = ?", *f.Dubbed)
+	}
+	if f.Group != nil && *f.Group != "" {
+		add("t.release_group = ? COLLATE NOCASE", *f.Group)
+	}
+
+	return conditions, args
+}
@@ -0,0 +1,74 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// PageCache je stav stránky výpisu z posledního úspěšného uložení. Crawler
+// podle něj posílá podmíněné požadavky (If-None-Match, If-Modified-Since)
+// a stránku se stejným obsahem znovu neparsuje ani neukládá.
+type PageCache struct {
+	URL          string
+	ETag         string
+	LastModified string
+	BodyHash     string    // sha256 těla odpovědi (hex)
+	TorrentCount int       // torrenty na stránce při posledním uložení
+	ChangedAt    time.Time // kdy se obsah stránky naposledy změnil
+	CheckedAt    time.Time // kdy se stránka naposledy stahovala
+}
+
+// GetPageCache vrátí uložený stav stránky, nebo nil, když ji ještě neznáme
+func (d *Database) GetPageCache(ctx context.Context, url string) (*PageCache, error) {
+	row := d.db.QueryRowContext(ctx, `
+	SELECT url, COALESCE(etag, ''), COALESCE(last_modified, ''), body_hash, torrent_count, changed_at, checked_at
+	FROM page_cache WHERE url = ?`, url)
+
+	var p PageCache
+	err := row.Scan(&p.URL, &p.ETag, &p.LastModified, &p.BodyHash, &p.TorrentCount, &p.ChangedAt, &p.CheckedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting page cache: %w", err)
+	}
+	return &p, nil
+}
+
+// SavePageCache uloží stav stránky po změně jejího obsahu
+func (t *Tx) SavePageCache(ctx context.Context, p PageCache) error {
+	return savePageCache(ctx, t.tx, p)
+}
+
+func savePageCache(ctx context.Context, db execer, p PageCache) error {
+	query := `
+	INSERT INTO page_cache (url, etag, last_modified, body_hash, torrent_count, changed_at, checked_at)
+	VALUES (?, NULLIF(?, ''), NULLIF(?, ''), ?, ?, ?, ?)
+	ON CONFLICT(url) DO UPDATE SET
+		etag = excluded.etag,
+		last_modified = excluded.last_modified,
+		body_hash = excluded.body_hash,
+		torrent_count = excluded.torrent_count,
+		changed_at = excluded.changed_at,
+		checked_at = excluded.checked_at
+	`
+
+	now := time.Now()
+	_, err := db.ExecContext(ctx, query, p.URL, p.ETag, p.LastModified, p.BodyHash, p.TorrentCount, now, now)
+	return err
+}
+
+// TouchPageCache zaznamená, že stránka je beze změny. Nové ETag nebo
+// Last-Modified (server je může poslat i k nezměněnému obsahu) přepíšou
+// uložené; prázdné hodnoty ponechají původní.
+func (t *Tx) TouchPageCache(ctx context.Context, url, etag, lastModified string) error {
+	_, err := t.tx.ExecContext(ctx, `
+	UPDATE page_cache SET
+		etag = COALESCE(NULLIF(?, ''), etag),
+		last_modified = COALESCE(NULLIF(?, ''), last_modified),
+		checked_at = ?
+	WHERE url = ?`, etag, lastModified, time.Now(), url)
+	return err
+}
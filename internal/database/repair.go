@@ -0,0 +1,78 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// AddedDateRepair shrnuje opravu dat přidání (RepairAddedDates)
+type AddedDateRepair struct {
+	Checked int // torrenty uložené před zavedením added_date_raw
+	Shifted int // půlnoc UTC přepočtená na půlnoc v časovém pásmu webu
+	Cleared int // smyšlené datum (čas stažení) nahrazené NULL
+}
+
+// RepairAddedDates opraví data přidání uložená starým parserem, který četl
+// "02/07/2025" jako půlnoc UTC a nečitelné datum nahradil časem stažení.
+// Týká se jen řádků bez added_date_raw: půlnoc UTC se přepočte na půlnoc
+// stejného dne v loc a doplní se raw text, jakýkoli jiný čas je fallback
+// a nahradí se NULL (příští crawl datum doplní). Opakované spuštění už nic
+// nezmění. S dryRun se jen spočítá, co by se změnilo.
+func (d *Database) RepairAddedDates(ctx context.Context, loc *time.Location, dryRun bool) (AddedDateRepair, error) {
+	var result AddedDateRepair
+	err := d.InTx(ctx, func(tx *Tx) error {
+		rows, err := tx.tx.QueryContext(ctx, `
+		SELECT id, added_date FROM torrents
+		WHERE added_date IS NOT NULL AND (added_date_raw IS NULL OR added_date_raw = '')`)
+		if err != nil {
+			return fmt.Errorf("getting added dates: %w", err)
+		}
+		dates := make(map[string]time.Time)
+		for rows.Next() {
+			var id string
+			var added sql.NullTime
+			if err := rows.Scan(&id, &added); err != nil {
+				rows.Close()
+				return fmt.Errorf("scanning added date: %w", err)
+			}
+			dates[id] = added.Time
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("getting added dates: %w", err)
+		}
+		result.Checked = len(dates)
+
+		stmt, err := tx.tx.PrepareContext(ctx, `UPDATE torrents SET added_date = ?, added_date_raw = ? WHERE id = ?`)
+		if err != nil {
+			return fmt.Errorf("preparing added date update: %w", err)
+		}
+		defer stmt.Close()
+
+		for id, added := range dates {
+			utc := added.UTC()
+			var newDate, raw interface{}
+			if utc.Hour() == 0 && utc.Minute() == 0 && utc.Second() == 0 && utc.Nanosecond() == 0 {
+				year, month, day := utc.Date()
+				newDate = time.Date(year, month, day, 0, 0, 0, 0, loc).UTC()
+				raw = utc.Format("02/01/2006")
+				result.Shifted++
+			} else {
+				result.Cleared++
+			}
+			if dryRun {
+				continue
+			}
+			if _, err := stmt.ExecContext(ctx, newDate, raw, id); err != nil {
+				return fmt.Errorf("updating added date of %s: %w", id, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return AddedDateRepair{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,61 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// execer je společné rozhraní *sql.DB a *sql.Tx pro zápisové dotazy
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// Tx je rozpracovaná transakce; nabízí stejné zápisy jako Database
+type Tx struct {
+	tx *sql.Tx
+}
+
+// InTx spustí fn v jedné transakci. Když fn vrátí chybu, nic z ní se neuloží.
+// Chyba jednotlivého dotazu transakci v SQLite nezruší, fn ji tedy může
+// ohlásit a pokračovat dalším řádkem.
+func (d *Database) InTx(ctx context.Context, fn func(tx *Tx) error) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(&Tx{tx: tx}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+func (t *Tx) UpsertTorrent(ctx context.Context, torrent *Torrent) error {
+	return upsertTorrent(ctx, t.tx, torrent)
+}
+
+func (t *Tx) UpsertTorrentDetails(ctx context.Context, details *TorrentDetails) error {
+	return upsertTorrentDetails(ctx, t.tx, details)
+}
+
+func (t *Tx) RecordTorrentStats(ctx context.Context, torrentID string, seeds, leeches int) error {
+	return recordTorrentStats(ctx, t.tx, torrentID, seeds, leeches)
+}
+
+func (t *Tx) MarkPageFailed(ctx context.Context, page int, url, errMsg string) error {
+	return markPageFailed(ctx, t.tx, page, url, errMsg)
+}
+
+func (t *Tx) ClearFailedPage(ctx context.Context, page int) error {
+	return clearFailedPage(ctx, t.tx, page)
+}
+
+func (t *Tx) RecordCrawlPage(ctx context.Context, runID int64, page int, status string, torrentCount int, errMsg string) error {
+	return recordCrawlPage(ctx, t.tx, runID, page, status, torrentCount, errMsg)
+}
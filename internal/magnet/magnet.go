@@ -0,0 +1,69 @@
+package magnet
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// DefaultTrackers jsou veřejné trackery přidávané do magnet odkazů, pokud
+// konfigurace neurčí jiné
+var DefaultTrackers = []string{
+	"udp://tracker.opentrackr.org:1337/announce",
+	"udp://open.demonii.com:1337/announce",
+	"udp://open.stealth.si:80/announce",
+	"udp://exodus.desync.com:6969/announce",
+}
+
+var infoHashRegex = regexp.MustCompile(`^[0-9a-fA-F]{40}$`)
+
+// IsInfoHash ověří, že řetězec je 40znakový hex SHA-1 info-hash
+func IsInfoHash(s string) bool {
+	return infoHashRegex.MatchString(s)
+}
+
+// URI sestaví magnet odkaz z info-hashe, zobrazovaného názvu a trackerů
+func URI(infoHash, name string, trackers []string) (string, error) {
+	if !IsInfoHash(infoHash) {
+		return "", fmt.Errorf("invalid info-hash %q", infoHash)
+	}
+
+	var b strings.Builder
+	b.WriteString("magnet:?xt=urn:btih:")
+	b.WriteString(strings.ToLower(infoHash))
+	if name != "" {
+		b.WriteString("&dn=")
+		// Mezera jako %20, ne "+": klienti dn nedekódují vždy jako formulář
+		b.WriteString(strings.ReplaceAll(url.QueryEscape(name), "+", "%20"))
+	}
+	for _, tracker := range uniqueTrackers(trackers) {
+		b.WriteString("&tr=")
+		b.WriteString(url.QueryEscape(tracker))
+	}
+	return b.String(), nil
+}
+
+// ParseTrackers rozdělí seznam trackerů oddělený čárkami (bez duplicit); prázdný vstup
+// vrátí DefaultTrackers
+func ParseTrackers(list string) []string {
+	trackers := uniqueTrackers(strings.Split(list, ","))
+	if len(trackers) == 0 {
+		return DefaultTrackers
+	}
+	return trackers
+}
+
+// uniqueTrackers ořízne mezery, vynechá prázdné položky a duplicity
+// a zachová pořadí prvního výskytu
+func uniqueTrackers(list []string) []string {
+	var trackers []string
+	seen := make(map[string]bool, len(list))
+	for _, tracker := range list {
+		if tracker = strings.TrimSpace(tracker); tracker != "" && !seen[tracker] {
+			seen[tracker] = true
+			trackers = append(trackers, tracker)
+		}
+	}
+	return trackers
+}
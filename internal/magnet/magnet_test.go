@@ -0,0 +1,98 @@
+package magnet
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const hash = "0123456789ABCDEF0123456789abcdef01234567"
+
+func TestURI(t *testing.T) {
+	tests := []struct {
+		name     string
+		hash     string
+		title    string
+		trackers []string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name: "jen hash, malými písmeny",
+			hash: hash,
+			want: "magnet:?xt=urn:btih:0123456789abcdef0123456789abcdef01234567",
+		},
+		{
+			name:  "escapování dn",
+			hash:  hash,
+			title: "Návrat & Pomsta 1+2 (2020) 50%/CZ",
+			want:  "magnet:?xt=urn:btih:0123456789abcdef0123456789abcdef01234567&dn=N%C3%A1vrat%20%26%20Pomsta%201%2B2%20%282020%29%2050%25%2FCZ",
+		},
+		{
+			name:     "trackery bez duplicit v původním pořadí",
+			hash:     hash,
+			trackers: []string{"udp://b:80/announce", " udp://a:80/announce", "", "udp://b:80/announce", "udp://a:80/announce "},
+			want:     "magnet:?xt=urn:btih:0123456789abcdef0123456789abcdef01234567&tr=udp%3A%2F%2Fb%3A80%2Fannounce&tr=udp%3A%2F%2Fa%3A80%2Fannounce",
+		},
+		{name: "prázdný hash", hash: "", wantErr: true},
+		{name: "krátký hash", hash: hash[:39], wantErr: true},
+		{name: "hash s ne-hex znakem", hash: "g" + hash[1:], wantErr: true},
+		{name: "hash s mezerou", hash: " " + hash[1:], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := URI(tt.hash, tt.title, tt.trackers)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("URI error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("URI = %q\n want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURIDefaultTrackers(t *testing.T) {
+	got, err := URI(hash, "x", DefaultTrackers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(got, "&tr="); n != len(DefaultTrackers) {
+		t.Errorf("URI has %d trackers, want %d", n, len(DefaultTrackers))
+	}
+}
+
+func TestParseTrackers(t *testing.T) {
+	tests := []struct {
+		list string
+		want []string
+	}{
+		{"", DefaultTrackers},
+		{" , ,", DefaultTrackers},
+		{"udp://a/announce", []string{"udp://a/announce"}},
+		{" udp://b/announce , udp://a/announce,udp://b/announce ,", []string{"udp://b/announce", "udp://a/announce"}},
+	}
+	for _, tt := range tests {
+		if got := ParseTrackers(tt.list); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseTrackers(%q) = %q, want %q", tt.list, got, tt.want)
+		}
+	}
+}
+
+func TestIsInfoHash(t *testing.T) {
+	tests := map[string]bool{
+		hash:                               true,
+		strings.ToUpper(hash):              true,
+		"":                                 false,
+		hash[:39]:                          false,
+		hash + "0":                         false,
+		"z" + hash[1:]:                     false,
+		"urn:btih:" + hash:                 false,
+		"ABCDEFGHIJKLMNOPQRSTUVWXYZ234567": false, // base32 hash nepodporujeme
+	}
+	for s, want := range tests {
+		if got := IsInfoHash(s); got != want {
+			t.Errorf("IsInfoHash(%q) = %v, want %v", s, got, want)
+		}
+	}
+}
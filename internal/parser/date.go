@@ -0,0 +1,87 @@
+package parser
+
+import (
+	"strings"
+	"time"
+	_ "time/tzdata" // distroless image nemá zoneinfo
+)
+
+// Location je časové pásmo, ve kterém sktorrent zobrazuje data
+var Location = mustLoadLocation("Europe/Bratislava")
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+// dateLayouts jsou formáty dne, které se ve výpisu objevují
+var dateLayouts = []string{
+	"02/01/2006",
+	"2/1/2006",
+	"02/1/2006",
+	"2/01/2006",
+	"02.01.2006",
+	"2.1.2006",
+}
+
+// timeLayouts jsou volitelné časy za datem ("02/07/2025 14:35")
+var timeLayouts = []string{
+	"15:04",
+	"15:04:05",
+}
+
+// ParseAddedDate přečte datum přidání tak, jak ho ukazuje výpis: "02/07/2025",
+// "02/07/2025 14:35", "dnes 14:35" nebo "včera". Výsledek je v Location,
+// relativní dny se počítají od now. Vrací false, když text datum neobsahuje.
+func ParseAddedDate(text string, now time.Time) (time.Time, bool) {
+	var fields []string
+	for _, field := range strings.Fields(NormalizeLabel(text)) {
+		// "dnes o 14:35", "today at 14:35"
+		if field != "o" && field != "v" && field != "at" {
+			fields = append(fields, field)
+		}
+	}
+	if len(fields) == 0 || len(fields) > 2 {
+		return time.Time{}, false
+	}
+
+	var year int
+	var month time.Month
+	var day int
+	today := now.In(Location)
+	switch fields[0] {
+	case "dnes", "today":
+		year, month, day = today.Date()
+	case "vcera", "yesterday":
+		year, month, day = today.AddDate(0, 0, -1).Date()
+	default:
+		parsed, ok := parseWithLayouts(fields[0], dateLayouts)
+		if !ok {
+			return time.Time{}, false
+		}
+		year, month, day = parsed.Date()
+	}
+
+	var clock time.Time
+	if len(fields) == 2 {
+		parsed, ok := parseWithLayouts(fields[1], timeLayouts)
+		if !ok {
+			return time.Time{}, false
+		}
+		clock = parsed
+	}
+
+	return time.Date(year, month, day, clock.Hour(), clock.Minute(), clock.Second(), 0, Location), true
+}
+
+func parseWithLayouts(value string, layouts []string) (time.Time, bool) {
+	for _, layout := range layouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,147 @@
+package parser
+
+import (
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Detail jsou metadata z detail stránky torrentu (details.php)
+type Detail struct {
+	Description    string
+	Uploader       string
+	FileCount      int
+	CompletedCount int // počet dokončených stažení
+	CSFDURL        string
+	IMDbURL        string
+	TrailerURL     string
+	Audio          string // jazyky zvuku, např. "CZ, EN"
+	Subtitles      string // jazyky titulků
+	InfoHash       string
+}
+
+var (
+	infoHashRegex  = regexp.MustCompile(`(?i)\b([0-9a-f]{40})\b`)
+	firstIntRegex  = regexp.MustCompile(`\d[\d\s.\x{00a0}]*\d|\d`)
+	audioLineRegex = regexp.MustCompile(`(?im)^\s*(?:audio|zvuk|jazyk|dabing)\s*[:\-]\s*(.+)$`)
+	subsLineRegex  = regexp.MustCompile(`(?im)^\s*(?:titulky|subtitles|subs)\s*[:\-]\s*(.+)$`)
+)
+
+// ParseDetail vytáhne z detail stránky (details.php) všechna dostupná metadata.
+// Stránka je tabulka řádků "popisek | hodnota", popisky se porovnávají bez
+// diakritiky. Chybějící údaje zůstanou prázdné.
+func ParseDetail(r io.Reader) (Detail, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return Detail{}, err
+	}
+	return parseDetail(doc), nil
+}
+
+func parseDetail(doc *goquery.Document) Detail {
+	var d Detail
+
+	doc.Find("tr").Each(func(i int, row *goquery.Selection) {
+		cells := row.ChildrenFiltered("td")
+		if cells.Length() < 2 {
+			return
+		}
+		label := NormalizeLabel(cells.First().Text())
+		value := cells.Eq(1)
+		text := strings.TrimSpace(value.Text())
+
+		switch {
+		case strings.HasPrefix(label, "popis") || label == "description":
+			if d.Description == "" {
+				d.Description = text
+			}
+		case strings.HasPrefix(label, "pridal") || strings.HasPrefix(label, "uploader") || strings.HasPrefix(label, "nahral"):
+			if d.Uploader == "" {
+				d.Uploader = strings.TrimSpace(value.Find("a").First().Text())
+				if d.Uploader == "" {
+					d.Uploader = text
+				}
+			}
+		case strings.Contains(label, "subor") || strings.Contains(label, "soubor") || label == "files":
+			if d.FileCount == 0 {
+				d.FileCount = parseFirstInt(text)
+			}
+		case strings.HasPrefix(label, "dokoncen") || strings.HasPrefix(label, "stiahnut") || strings.HasPrefix(label, "stazen") || label == "snatched":
+			if d.CompletedCount == 0 {
+				d.CompletedCount = parseFirstInt(text)
+			}
+		case strings.HasPrefix(label, "info hash") || label == "infohash" || label == "hash":
+			if m := infoHashRegex.FindStringSubmatch(text); m != nil {
+				d.InfoHash = strings.ToLower(m[1])
+			}
+		}
+	})
+
+	// Odkazy hledáme v celé stránce
+	d.CSFDURL = firstHref(doc,
+		`a[itemprop="sameAs"][href*="csfd.cz"]`,
+		`a[href*="csfd.cz/film/"]`,
+		`a[href*="csfd.sk/film/"]`,
+	)
+	d.IMDbURL = firstHref(doc, `a[href*="imdb.com/title/"]`)
+	d.TrailerURL = firstHref(doc,
+		`a[href*="youtube.com/watch"]`,
+		`a[href*="youtu.be/"]`,
+	)
+	if d.TrailerURL == "" {
+		if src, ok := doc.Find(`iframe[src*="youtube.com/embed/"]`).First().Attr("src"); ok {
+			d.TrailerURL = src
+		}
+	}
+
+	// Zvuk a titulky bývají uvedené v popisu jako "Jazyk: CZ, EN"
+	if m := audioLineRegex.FindStringSubmatch(d.Description); m != nil {
+		d.Audio = strings.TrimSpace(m[1])
+	}
+	if m := subsLineRegex.FindStringSubmatch(d.Description); m != nil {
+		d.Subtitles = strings.TrimSpace(m[1])
+	}
+
+	return d
+}
+
+// diacriticsReplacer odstraní českou a slovenskou diakritiku
+var diacriticsReplacer = strings.NewReplacer(
+	"á", "a", "ä", "a", "č", "c", "ď", "d", "é", "e", "ě", "e", "í", "i", "ĺ", "l",
+	"ľ", "l", "ň", "n", "ó", "o", "ô", "o", "ŕ", "r", "ř", "r", "š", "s", "ť", "t",
+	"ú", "u", "ů", "u", "ý", "y", "ž", "z",
+)
+
+// NormalizeLabel převede popisek na malá písmena bez diakritiky a dvojtečky
+func NormalizeLabel(label string) string {
+	label = diacriticsReplacer.Replace(strings.ToLower(strings.TrimSpace(label)))
+	return strings.TrimSpace(strings.TrimSuffix(label, ":"))
+}
+
+// parseFirstInt vrátí první číslo v textu včetně oddělovačů tisíců ("1 234 x" -> 1234)
+func parseFirstInt(text string) int {
+	var digits strings.Builder
+	for _, r := range firstIntRegex.FindString(text) {
+		if r >= '0' && r <= '9' {
+			digits.WriteRune(r)
+		}
+	}
+	n, _ := strconv.Atoi(digits.String())
+	return n
+}
+
+// firstHref vrátí href prvního odkazu, který odpovídá některému ze selektorů
+func firstHref(doc *goquery.Document, selectors ...string) string {
+	for _, selector := range selectors {
+		link := doc.Find(selector).First()
+		if link.Length() > 0 {
+			if href, exists := link.Attr("href"); exists {
+				return href
+			}
+		}
+	}
+	return ""
+}
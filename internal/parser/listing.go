@@ -0,0 +1,284 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// BaseURL je adresa, ke které se doplňují relativní odkazy z výpisu
+const BaseURL = "https://sktorrent.eu/torrent/"
+
+// Listing je jeden torrent z výpisu (torrents_v2.php)
+type Listing struct {
+	ID         string // info-hash z odkazu na detail
+	Name       string
+	URL        string // absolutní odkaz na details.php
+	Category   string
+	ImageURL   string
+	SizeMB     float64
+	Seeds      int
+	Leeches    int
+	CSFDRating int       // 0 = bez hodnocení
+	AddedDate  time.Time // v Location; nulový čas = datum se nepodařilo přečíst
+
+	// Datum přidání tak, jak je na stránce ("02/07/2025", "dnes 14:35")
+	AddedDateRaw string
+
+	// Údaje, které se v řádku nenašly nebo nešly přečíst (FieldSize, ...)
+	Missing []string
+}
+
+// Názvy údajů v Listing.Missing
+const (
+	FieldSize     = "size"
+	FieldDate     = "date"
+	FieldSeeds    = "seeds"
+	FieldLeeches  = "leeches"
+	FieldCategory = "category"
+)
+
+// ListingPage je celá stránka výpisu včetně údajů pro kontrolu kvality
+type ListingPage struct {
+	Listings []Listing
+	// DetailLinks je počet různých torrentů, na které odkazují buňky výpisu
+	// (TD.lista s details.php?id=...), bez ohledu na to, zda se řádek podařilo
+	// přečíst. Odkazy mimo výpis (postranní panely, reklamy) se nepočítají.
+	DetailLinks int
+	// LastPageLink je nejvyšší číslo stránky v odkazech stránkování
+	// (torrents_v2.php?...&page=N); 0 = stránka žádné odkazy nemá
+	LastPageLink int
+}
+
+var (
+	csfdRegex = regexp.MustCompile(`=\s*CSFD\s*(\d+)%`)
+	sizeRegex = regexp.MustCompile(`([0-9.]+)\s*(GB|TB|MB|KB)`)
+)
+
+// ParseListing přečte torrenty ze stránky výpisu. Buňky bez odkazu na detail
+// nebo bez názvu se přeskočí; prázdný výsledek chybou není. Relativní data
+// ("dnes", "včera") se počítají od aktuálního času.
+func ParseListing(r io.Reader) ([]Listing, error) {
+	page, err := ParseListingPage(r, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return page.Listings, nil
+}
+
+// ParseListingPage je ParseListing, který navíc spočítá odkazy na detaily,
+// aby šlo poznat řádky, které parser nepřečetl. Relativní data se počítají
+// od now (čas stažení stránky).
+func ParseListingPage(r io.Reader, now time.Time) (ListingPage, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return ListingPage{}, err
+	}
+
+	var listings []Listing
+
+	doc.Find("TD.lista").Each(func(i int, s *goquery.Selection) {
+		// Zkontrolovat, zda obsahuje odkaz na details.php
+		detailsLink := s.Find("A[href*='details.php']")
+		if detailsLink.Length() == 0 {
+			return
+		}
+
+		var listing Listing
+
+		// Název torrentu
+		listing.Name = strings.TrimSpace(detailsLink.Text())
+		if listing.Name == "" {
+			return
+		}
+
+		// URL a ID
+		if href, exists := detailsLink.Attr("href"); exists {
+			listing.URL = BaseURL + href
+			// Extrakce ID z URL parametrů
+			listing.ID = extractTorrentID(href)
+		}
+
+		// ČSFD hodnocení z názvu
+		listing.CSFDRating = parseCSFDRating(listing.Name)
+
+		// Kategorie
+		categoryLink := s.Find("a[href*='torrents_v2.php?category=']")
+		if categoryLink.Length() > 0 {
+			listing.Category = strings.TrimSpace(categoryLink.Text())
+		}
+
+		// URL obrázku
+		imgElement := s.Find("img.lozad")
+		if imgElement.Length() > 0 {
+			if dataSrc, exists := imgElement.Attr("data-src"); exists {
+				listing.ImageURL = dataSrc
+			}
+		}
+
+		// Velikost, seeders, leechers
+		found := parseMetadata(s, &listing, now)
+		listing.Missing = missingFields(listing, found)
+
+		listings = append(listings, listing)
+	})
+
+	return ListingPage{Listings: listings, DetailLinks: countDetailLinks(doc), LastPageLink: lastPageLink(doc)}, nil
+}
+
+// countDetailLinks spočítá různá ID v odkazech na details.php uvnitř buněk výpisu
+func countDetailLinks(doc *goquery.Document) int {
+	ids := make(map[string]bool)
+	doc.Find("TD.lista a[href*='details.php']").Each(func(i int, s *goquery.Selection) {
+		href, _ := s.Attr("href")
+		if id := extractTorrentID(href); id != "" {
+			ids[id] = true
+		}
+	})
+	return len(ids)
+}
+
+// lastPageLink najde nejvyšší page=N v odkazech na výpis
+func lastPageLink(doc *goquery.Document) int {
+	last := 0
+	doc.Find("a[href*='page=']").Each(func(i int, s *goquery.Selection) {
+		href, _ := s.Attr("href")
+		u, err := url.Parse(href)
+		if err != nil || (u.Path != "" && !strings.HasSuffix(u.Path, "torrents_v2.php")) {
+			return
+		}
+		if page, err := strconv.Atoi(u.Query().Get("page")); err == nil && page > last {
+			last = page
+		}
+	})
+	return last
+}
+
+func missingFields(listing Listing, found map[string]bool) []string {
+	var missing []string
+	if listing.SizeMB == 0 {
+		missing = append(missing, FieldSize)
+	}
+	if listing.AddedDate.IsZero() {
+		missing = append(missing, FieldDate)
+	}
+	if !found[FieldSeeds] {
+		missing = append(missing, FieldSeeds)
+	}
+	if !found[FieldLeeches] {
+		missing = append(missing, FieldLeeches)
+	}
+	if listing.Category == "" {
+		missing = append(missing, FieldCategory)
+	}
+	return missing
+}
+
+func extractTorrentID(href string) string {
+	// href vypadá jako: details.php?name=...&id=339688748bd23e2ec25945937872287be91343f9
+	u, err := url.Parse(href)
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("id")
+}
+
+func parseCSFDRating(name string) int {
+	matches := csfdRegex.FindStringSubmatch(name)
+	if len(matches) >= 2 {
+		rating, err := strconv.Atoi(matches[1])
+		if err == nil {
+			return rating
+		}
+	}
+	return 0
+}
+
+// parseMetadata doplní velikost, datum, seeds a leeches a vrátí, které
+// z řádků "Odosielaju"/"Stahuju" se našly (nula je platná hodnota)
+func parseMetadata(s *goquery.Selection, listing *Listing, now time.Time) map[string]bool {
+	found := make(map[string]bool)
+	s.Find("*").Each(func(j int, textNode *goquery.Selection) {
+		text := textNode.Text()
+		if !strings.Contains(text, "Velkost") {
+			return
+		}
+
+		lines := strings.Split(text, "\n")
+		for _, line := range lines {
+			line = strings.TrimSpace(line)
+
+			if strings.HasPrefix(line, "Velkost") {
+				// Parsovat velikost a datum z řádku jako "Velkost: 6.9 GB | Pridany 02/07/2025"
+				parseSizeAndDate(line, listing, now)
+			} else if strings.HasPrefix(line, "Odosielaju") {
+				seedText := strings.TrimSpace(strings.Replace(line, "Odosielaju :", "", 1))
+				if n, _ := fmt.Sscanf(seedText, "%d", &listing.Seeds); n == 1 {
+					found[FieldSeeds] = true
+				}
+			} else if strings.HasPrefix(line, "Stahuju") {
+				leechText := strings.TrimSpace(strings.Replace(line, "Stahuju :", "", 1))
+				if n, _ := fmt.Sscanf(leechText, "%d", &listing.Leeches); n == 1 {
+					found[FieldLeeches] = true
+				}
+			}
+		}
+	})
+	return found
+}
+
+func parseSizeAndDate(line string, listing *Listing, now time.Time) {
+	// Očekáváme formát: "Velkost 6.9 GB | Pridany 02/07/2025"
+	parts := strings.Split(line, "|")
+
+	// Parsování velikosti
+	if len(parts) >= 1 {
+		sizePart := strings.TrimSpace(parts[0])
+		sizePart = strings.Replace(sizePart, "Velkost", "", 1)
+		sizePart = strings.TrimSpace(sizePart)
+		listing.SizeMB = ParseSizeMB(sizePart)
+	}
+
+	// Parsování data
+	if len(parts) >= 2 {
+		datePart := strings.TrimSpace(parts[1])
+		datePart = strings.Replace(datePart, "Pridany", "", 1)
+		datePart = strings.TrimSpace(datePart)
+		listing.AddedDateRaw = datePart
+		listing.AddedDate, _ = ParseAddedDate(datePart, now)
+	}
+}
+
+// ParseSizeMB převede velikost z formátu "6.9 GB", "1.2 TB", "500 MB" atd. na MB
+func ParseSizeMB(sizeStr string) float64 {
+	matches := sizeRegex.FindStringSubmatch(sizeStr)
+
+	if len(matches) != 3 {
+		return 0.0
+	}
+
+	value, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return 0.0
+	}
+
+	unit := strings.ToUpper(matches[2])
+	switch unit {
+	case "KB":
+		return value / 1024 // Convert KB to MB
+	case "MB":
+		return value
+	case "GB":
+		return value * 1024 // Convert GB to MB
+	case "TB":
+		return value * 1024 * 1024 // Convert TB to MB
+	default:
+		return value
+	}
+}
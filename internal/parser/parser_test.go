@@ -0,0 +1,106 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// go test ./internal/parser -update přepíše golden soubory aktuálním výstupem;
+// změny v testdata/golden je pak potřeba projít v diffu
+var update = flag.Bool("update", false, "přepsat golden soubory v testdata/golden")
+
+// fetchedAt je čas stažení fixtures; relativní data ("dnes", "včera") se
+// počítají od něj
+var fetchedAt = time.Date(2025, 7, 2, 18, 30, 0, 0, Location)
+
+func TestParseListing(t *testing.T) {
+	// Jen stránka s absolutními daty, ParseListing počítá relativní od time.Now()
+	listings, err := ParseListing(openFixture(t, "listing.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listings) == 0 {
+		t.Fatal("no listings parsed, has the layout changed?")
+	}
+	checkGolden(t, "ParseListing", "listing.html", listings)
+}
+
+func TestParseListingPage(t *testing.T) {
+	fixtures := []string{
+		"listing.html",         // běžná stránka, všechny řádky celé
+		"listing_quality.html", // relativní data a řádky bez velikosti, data, stats, kategorie nebo názvu
+		"listing_empty.html",   // stránka za koncem výpisu
+		"listing_sidebar.html", // postranní panel s odkazy na torrenty mimo výpis
+	}
+	for _, fixture := range fixtures {
+		t.Run(fixture, func(t *testing.T) {
+			page, err := ParseListingPage(openFixture(t, fixture), fetchedAt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkGolden(t, "ParseListingPage", fixture, page)
+		})
+	}
+}
+
+func TestParseDetail(t *testing.T) {
+	fixtures := []string{
+		"detail.html",       // slovenské popisky, ČSFD přes itemprop, trailer v iframe
+		"detail_cz.html",    // české popisky, csfd.sk, odkaz na YouTube
+		"detail_empty.html", // smazaný torrent
+	}
+	for _, fixture := range fixtures {
+		t.Run(fixture, func(t *testing.T) {
+			detail, err := ParseDetail(openFixture(t, fixture))
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkGolden(t, "ParseDetail", fixture, detail)
+		})
+	}
+}
+
+func openFixture(t *testing.T, name string) *os.File {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.Close() })
+	return f
+}
+
+// checkGolden porovná got (jako JSON) s testdata/golden/<fn>/<fixture>.json
+func checkGolden(t *testing.T, fn, fixture string, got interface{}) {
+	t.Helper()
+	data, err := json.MarshalIndent(got, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data = append(data, '\n')
+
+	path := filepath.Join("testdata", "golden", fn, strings.TrimSuffix(fixture, ".html")+".json")
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run go test -update to create it)", err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Errorf("%s(%s) differs from %s:\ngot:\n%s\nwant:\n%s", fn, fixture, path, data, want)
+	}
+}
@@ -0,0 +1,330 @@
+package release
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Info jsou údaje vytažené z názvu torrentu, např.
+// "Ironheart S01E04-E06 (CZ/EN)[WEB-DL][1080p] = CSFD 39%"
+type Info struct {
+	Title         string   // název (u "CZ název / originál" ten první)
+	OriginalTitle string   // poslední z variant názvu oddělených " / "
+	Year          int      // 0 = neuvedeno
+	Season        int      // 0 = neuvedeno
+	SeasonTo      int      // konec rozsahu sérií ("1-3 série"), jinak stejné jako Season
+	EpisodeFrom   int      // 0 = neuvedeno
+	EpisodeTo     int      // konec rozsahu dílů ("S01E04-E06"), jinak stejné jako EpisodeFrom
+	Resolution    string   // "720p", "1080p", "2160p", ...
+	Source        string   // "WEB-DL", "WEBRip", "BluRay", "CAM", ...
+	Codec         string   // "HEVC", "H.264", "AV1", "XviD"
+	HDR           string   // "HDR", "HDR10", "HDR10+", "DV" nebo kombinace "HDR10+/DV"
+	Languages     []string // jazyky zvuku ("CZ", "EN", ...)
+	Dubbed        bool     // český nebo slovenský zvuk
+	Group         string   // release group ("FitGirl", "DODI", ...)
+}
+
+var (
+	csfdSuffixRegex = regexp.MustCompile(`(?i)\s*=\s*CSFD\b.*$`)
+
+	episodeRegex      = regexp.MustCompile(`(?i)\bS(\d{1,2})\s*E(\d{1,3})(?:\s*-\s*(?:S\d{1,2})?E?(\d{1,3}))?`)
+	seasonRegex       = regexp.MustCompile(`(?i)\bS(\d{1,2})(?:\s*-\s*S?(\d{1,2}))?\b`)
+	crossEpisodeRegex = regexp.MustCompile(`\b(\d{1,2})x(\d{2,3})\b`)
+	episodeOnlyRegex  = regexp.MustCompile(`(?i)\bE(\d{1,3})(?:\s*-\s*E?(\d{1,3}))?\b`)
+	seasonRangeRegex  = regexp.MustCompile(`(?i)\b(\d{1,2})\.?\s*-\s*(\d{1,2})\.?\s*(?:s[eé]ri[eaií]|season)`)
+	seasonWordRegex   = regexp.MustCompile(`(?i)\b(\d{1,2})[.-]?\s*(?:s[eé]ri[eaií]|season|řada)|(?:season|s[eé]rie|s[eé]ria|řada)\s*(\d{1,2})(?:\s*-\s*(\d{1,2}))?\b`)
+
+	yearParenRegex = regexp.MustCompile(`[(\[]((?:19|20)\d{2})[)\]]`)
+	yearBareRegex  = regexp.MustCompile(`(?:^|[^\d.v])((?:19|20)\d{2})(?:[^\d]|$)`)
+
+	resolutionRegex = regexp.MustCompile(`(?i)\b(480|576|720|1080|1440|2160)[pi]\b`)
+	uhdRegex        = regexp.MustCompile(`(?i)\b(?:4K|UHD)\b`)
+	fullHDRegex     = regexp.MustCompile(`(?i)\b(?:FullHD|FHD)\b`)
+
+	hdr10PlusRegex = regexp.MustCompile(`(?i)\bHDR(?:10)?\+`)
+	hdr10Regex     = regexp.MustCompile(`(?i)\bHDR10\b`)
+	hdrRegex       = regexp.MustCompile(`(?i)\bHDR\b`)
+	// "DV" jen velkými písmeny: \b nezná diakritiku a "dvě" by se shodovalo
+	dolbyVision = regexp.MustCompile(`\bDV\b|(?i:\b(?:DoVi|Dolby\s*Vision)\b)`)
+
+	languageGroupRegex = regexp.MustCompile(`[(\[]\+?\s*([A-Za-z]{2,3}(?:\s*[/,+-]\s*[A-Za-z]{2,3})*)\s*[)\]]`)
+	dubbingRegex       = regexp.MustCompile(`(?i)\b(CZ|SK|EN)\s*(?:dabing|dab)\b`)
+
+	repackGroupRegex = regexp.MustCompile(`(?i)\[\s*([^\]\s]+?)[\s-]*Repack\s*\]`)
+	sceneGroupRegex  = regexp.MustCompile(`-([A-Za-z0-9]+)$`)
+	suffixGroupRegex = regexp.MustCompile(`(?:-|\s-\s)([A-Z0-9]{3,})$`)
+	sceneExtRegex    = regexp.MustCompile(`(?i)\.(?:mkv|mp4|avi)$`)
+)
+
+// rule přiřazuje vzoru v názvu normalizovanou hodnotu
+type rule struct {
+	re    *regexp.Regexp
+	value string
+}
+
+// sources jsou seřazené od nejkonkrétnějších
+var sources = []rule{
+	{regexp.MustCompile(`(?i)\bWEB-?DL\b`), "WEB-DL"},
+	{regexp.MustCompile(`(?i)\bWEB-?Rip\b`), "WEBRip"},
+	{regexp.MustCompile(`(?i)\b(?:Blu-?Ray|BD-?Rip|BR-?Rip|BD-?Remux|Remux)\b`), "BluRay"},
+	{regexp.MustCompile(`(?i)\bHDTV\b`), "HDTV"},
+	{regexp.MustCompile(`(?i)\bTV-?Rip\b`), "TVRip"},
+	{regexp.MustCompile(`(?i)\bDVD-?Rip\b|\bDVD(?:5|9)?\b`), "DVD"},
+	{regexp.MustCompile(`(?i)\bHD-?Rip\b`), "HDRip"},
+	{regexp.MustCompile(`(?i)\b(?:HD)?CAM(?:-?Rip)?\b`), "CAM"},
+	{regexp.MustCompile(`(?i)\bHD-?TS\b|\bTELESYNC\b|\bHD-?TC\b|\bTELECINE\b|\[TS\]`), "TS"},
+}
+
+var codecs = []rule{
+	{regexp.MustCompile(`(?i)\b(?:HEVC|[xh]\.?265)\b`), "HEVC"},
+	{regexp.MustCompile(`(?i)\b(?:AVC|[xh]\.?264)\b`), "H.264"},
+	{regexp.MustCompile(`(?i)\bAV1\b`), "AV1"},
+	{regexp.MustCompile(`(?i)\b(?:XviD|DivX)\b`), "XviD"},
+}
+
+// languageCodes převádí zkratky jazyků v názvech na jednotný tvar
+var languageCodes = map[string]string{
+	"CZ": "CZ", "CZE": "CZ", "CS": "CZ",
+	"SK": "SK", "SVK": "SK", "SLO": "SK",
+	"EN": "EN", "ENG": "EN",
+	"DE": "DE", "GER": "DE",
+	"FR": "FR", "FRE": "FR",
+	"ES": "ES", "SPA": "ES",
+	"IT": "IT", "ITA": "IT",
+	"PL": "PL", "POL": "PL",
+	"HU": "HU", "HUN": "HU",
+	"RU": "RU", "RUS": "RU",
+	"JP": "JP", "JAP": "JP", "JPN": "JP",
+	"KOR": "KOR", "KR": "KOR",
+	"CHN": "CHN",
+}
+
+// Parse rozebere název torrentu. Neznámé části zůstanou prázdné, funkce
+// nikdy nehlásí chybu.
+func Parse(name string) Info {
+	var info Info
+
+	name = strings.TrimSpace(csfdSuffixRegex.ReplaceAllString(name, ""))
+
+	// Scénové názvy "Film.2024.1080p.WEB-DL.x264-GRP" jsou bez mezer
+	if !strings.Contains(name, " ") && strings.Count(name, ".") >= 2 {
+		name = sceneExtRegex.ReplaceAllString(name, "")
+		if m := sceneGroupRegex.FindStringSubmatch(name); m != nil {
+			info.Group = m[1]
+		}
+		name = strings.ReplaceAll(name, ".", " ")
+	}
+
+	// Pozice, kde končí název a začínají tagy
+	cut := len(name)
+	cutAt := func(pos int) {
+		if pos > 0 && pos < cut {
+			cut = pos
+		}
+	}
+	if i := strings.IndexAny(name, "(["); i >= 0 {
+		cutAt(i)
+	}
+
+	seasonAt, seasonEnd := parseSeason(name, &info)
+	cutAt(seasonAt)
+
+	if m := yearParenRegex.FindStringSubmatchIndex(name); m != nil {
+		info.Year, _ = strconv.Atoi(name[m[2]:m[3]])
+	} else if m := yearBareRegex.FindStringSubmatchIndex(name); m != nil {
+		info.Year, _ = strconv.Atoi(name[m[2]:m[3]])
+		cutAt(m[2])
+	}
+
+	if m := resolutionRegex.FindStringSubmatchIndex(name); m != nil {
+		info.Resolution = name[m[2]:m[3]] + "p"
+		cutAt(m[0])
+	} else if m := uhdRegex.FindStringIndex(name); m != nil && m[0] > 0 {
+		// "4K Video Downloader" je název, ne rozlišení
+		info.Resolution = "2160p"
+		cutAt(m[0])
+	} else if m := fullHDRegex.FindStringIndex(name); m != nil {
+		info.Resolution = "1080p"
+		cutAt(m[0])
+	}
+
+	for _, r := range sources {
+		if m := r.re.FindStringIndex(name); m != nil {
+			info.Source = r.value
+			cutAt(m[0])
+			break
+		}
+	}
+	for _, r := range codecs {
+		if m := r.re.FindStringIndex(name); m != nil {
+			info.Codec = r.value
+			cutAt(m[0])
+			break
+		}
+	}
+
+	info.HDR = parseHDR(name)
+	parseLanguages(name, &info)
+
+	if m := repackGroupRegex.FindStringSubmatch(name); m != nil {
+		info.Group = m[1]
+	} else if m := suffixGroupRegex.FindStringSubmatchIndex(name); m != nil && info.Group == "" &&
+		(m[1] <= seasonAt || m[0] >= seasonEnd) {
+		// Hry bývají pojmenované "Název-TENOKE" nebo "Název - SKIDROW";
+		// konec rozsahu "S01E04-E06" nebo "S01-S03" skupinou není
+		info.Group = name[m[2]:m[3]]
+		cutAt(m[0])
+	}
+
+	info.Title, info.OriginalTitle = splitTitles(name[:cut])
+	return info
+}
+
+// parseSeason najde sérii a díly, vrátí začátek a konec nálezu (-1, -1,
+// když chybí)
+func parseSeason(name string, info *Info) (int, int) {
+	if m := episodeRegex.FindStringSubmatchIndex(name); m != nil {
+		info.Season = atoi(name, m[2], m[3])
+		info.EpisodeFrom = atoi(name, m[4], m[5])
+		info.EpisodeTo = info.EpisodeFrom
+		if m[6] >= 0 {
+			if to := atoi(name, m[6], m[7]); to > info.EpisodeFrom {
+				info.EpisodeTo = to
+			}
+		}
+		info.SeasonTo = info.Season
+		return m[0], m[1]
+	}
+	if m := crossEpisodeRegex.FindStringSubmatchIndex(name); m != nil {
+		info.Season = atoi(name, m[2], m[3])
+		info.SeasonTo = info.Season
+		info.EpisodeFrom = atoi(name, m[4], m[5])
+		info.EpisodeTo = info.EpisodeFrom
+		return m[0], m[1]
+	}
+	if m := episodeOnlyRegex.FindStringSubmatchIndex(name); m != nil {
+		info.EpisodeFrom = atoi(name, m[2], m[3])
+		info.EpisodeTo = info.EpisodeFrom
+		if m[4] >= 0 {
+			if to := atoi(name, m[4], m[5]); to > info.EpisodeFrom {
+				info.EpisodeTo = to
+			}
+		}
+		return m[0], m[1]
+	}
+	if all := seasonRegex.FindAllStringSubmatchIndex(name, -1); all != nil {
+		m := all[0]
+		info.Season = atoi(name, m[2], m[3])
+		info.SeasonTo = info.Season
+		// Rozsah "S01-S03" i vyjmenované série "[S01][S02]"
+		for _, m := range all {
+			to := atoi(name, m[2], m[3])
+			if m[4] >= 0 {
+				to = atoi(name, m[4], m[5])
+			}
+			info.SeasonTo = max(info.SeasonTo, to)
+		}
+		return m[0], m[1]
+	}
+	if m := seasonRangeRegex.FindStringSubmatchIndex(name); m != nil {
+		info.Season = atoi(name, m[2], m[3])
+		info.SeasonTo = info.Season
+		if to := atoi(name, m[4], m[5]); to > info.Season {
+			info.SeasonTo = to
+		}
+		return m[0], m[1]
+	}
+	if m := seasonWordRegex.FindStringSubmatchIndex(name); m != nil {
+		if m[2] >= 0 {
+			info.Season = atoi(name, m[2], m[3])
+		} else {
+			info.Season = atoi(name, m[4], m[5])
+		}
+		info.SeasonTo = info.Season
+		if m[6] >= 0 {
+			// "séria 1-3"
+			if to := atoi(name, m[6], m[7]); to > info.Season {
+				info.SeasonTo = to
+			}
+		}
+		return m[0], m[1]
+	}
+	return -1, -1
+}
+
+// parseHDR vrátí nejkonkrétnější HDR formát, případně doplněný o Dolby Vision
+func parseHDR(name string) string {
+	var formats []string
+	switch {
+	case hdr10PlusRegex.MatchString(name):
+		formats = append(formats, "HDR10+")
+	case hdr10Regex.MatchString(name):
+		formats = append(formats, "HDR10")
+	case hdrRegex.MatchString(name):
+		formats = append(formats, "HDR")
+	}
+	if dolbyVision.MatchString(name) {
+		formats = append(formats, "DV")
+	}
+	return strings.Join(formats, "/")
+}
+
+// parseLanguages přečte skupiny jazyků "(CZ/EN)" a zmínky "SK dabing"
+func parseLanguages(name string, info *Info) {
+	seen := make(map[string]bool)
+	add := func(code string) {
+		if lang, ok := languageCodes[strings.ToUpper(code)]; ok && !seen[lang] {
+			seen[lang] = true
+			info.Languages = append(info.Languages, lang)
+		}
+	}
+
+	for _, m := range languageGroupRegex.FindAllStringSubmatch(name, -1) {
+		codes := strings.FieldsFunc(m[1], func(r rune) bool {
+			return r == '/' || r == ',' || r == '+' || r == '-' || r == ' '
+		})
+		// Skupina se počítá jen tehdy, když jsou v ní samé jazyky ("(GOG)" ne)
+		known := true
+		for _, code := range codes {
+			if _, ok := languageCodes[strings.ToUpper(code)]; !ok {
+				known = false
+				break
+			}
+		}
+		if known {
+			for _, code := range codes {
+				add(code)
+			}
+		}
+	}
+	for _, m := range dubbingRegex.FindAllStringSubmatch(name, -1) {
+		add(m[1])
+	}
+
+	info.Dubbed = seen["CZ"] || seen["SK"]
+}
+
+// splitTitles rozdělí "CZ název / originální název" a očistí okraje
+func splitTitles(title string) (string, string) {
+	var parts []string
+	for _, part := range strings.Split(title, " / ") {
+		part = strings.Trim(part, " -–:,.+")
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	switch len(parts) {
+	case 0:
+		return "", ""
+	case 1:
+		return parts[0], ""
+	default:
+		return parts[0], parts[len(parts)-1]
+	}
+}
+
+func atoi(s string, from, to int) int {
+	n, _ := strconv.Atoi(s[from:to])
+	return n
+}
@@ -0,0 +1,272 @@
+package release
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestParse je korpus skutečných názvů z torrents.db (sktorrent.eu). Nové
+// případy přidávej sem, ideálně se jménem tak, jak je na webu.
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		want Info
+	}{
+		{"Ironheart S01E04-E06 = CSFD 39%", Info{Title: "Ironheart", Season: 1, SeasonTo: 1, EpisodeFrom: 4, EpisodeTo: 6}},
+		{"Ironheart S01E04-E06 (CZ/SK/EN)[WEB-DL][1080p] = CSFD 39%", Info{Title: "Ironheart", Season: 1, SeasonTo: 1, EpisodeFrom: 4, EpisodeTo: 6, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ", "SK", "EN"}, Dubbed: true}},
+		{"Auta / Cars (2006)(CZ/SK/EN)[1080p][Blu-Ray] = CSFD 83%", Info{Title: "Auta", OriginalTitle: "Cars", Year: 2006, Resolution: "1080p", Source: "BluRay", Languages: []string{"CZ", "SK", "EN"}, Dubbed: true}},
+		{"Chraň nás od zlého / Deliver Us from Evil (2014)(CZ/EN)[1080p][Blu-Ray] = CSFD 72%", Info{Title: "Chraň nás od zlého", OriginalTitle: "Deliver Us from Evil", Year: 2014, Resolution: "1080p", Source: "BluRay", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Loupež po italsku / The Italian Job (2003) 4K Full BD = CSFD 77%", Info{Title: "Loupež po italsku", OriginalTitle: "The Italian Job", Year: 2003, Resolution: "2160p"}},
+		{"Obrazy starého sveta (1972)(SK)[1080p][Blu-Ray] = CSFD 86%", Info{Title: "Obrazy starého sveta", Year: 1972, Resolution: "1080p", Source: "BluRay", Languages: []string{"SK"}, Dubbed: true}},
+		{"Prečo lietadlá padajú / Why Planes Crash S01 (5 dielov)(2009)(SK)[TVRip][HEVC][720p] = CSFD 61%", Info{Title: "Prečo lietadlá padajú", OriginalTitle: "Why Planes Crash", Year: 2009, Season: 1, SeasonTo: 1, Resolution: "720p", Source: "TVRip", Codec: "HEVC", Languages: []string{"SK"}, Dubbed: true}},
+		{"Clarksonova farma / Clarkson's Farm S04 (EN)[WEB-DL][1080p] = CSFD 95%", Info{Title: "Clarksonova farma", OriginalTitle: "Clarkson's Farm", Season: 4, SeasonTo: 4, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"EN"}}},
+		{"Československý vesmír S01 (2023)(SK)[TvRip] = CSFD 83%", Info{Title: "Československý vesmír", Year: 2023, Season: 1, SeasonTo: 1, Source: "TVRip", Languages: []string{"SK"}, Dubbed: true}},
+		{"Titan: Neštěstí jménem OceanGate / Titan: The OceanGate Disaster (2025)(CZ)[1080p][WEB-DL] = CSFD 71%", Info{Title: "Titan: Neštěstí jménem OceanGate", OriginalTitle: "Titan: The OceanGate Disaster", Year: 2025, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Konto separato (1996)(CZ)[WebRip] = CSFD 49%", Info{Title: "Konto separato", Year: 1996, Source: "WEBRip", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Policajt ze San Francisca / Metro (1997)(CZ/EN)[1080p][HEVC] = CSFD 61%", Info{Title: "Policajt ze San Francisca", OriginalTitle: "Metro", Year: 1997, Resolution: "1080p", Codec: "HEVC", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"V zajetí démonů 2 / The Conjuring 2 (2016)(CZ/EN)[AIUpscale][2160p][HDR+/DV][HEVC] = CSFD 82%", Info{Title: "V zajetí démonů 2", OriginalTitle: "The Conjuring 2", Year: 2016, Resolution: "2160p", Codec: "HEVC", HDR: "HDR10+/DV", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Star Trek VI: Neobjevená země / Star Trek VI: The Undiscovered Country (1991)(CZ/EN)[2160p][HDR][HEVC] = CSFD 75%", Info{Title: "Star Trek VI: Neobjevená země", OriginalTitle: "Star Trek VI: The Undiscovered Country", Year: 1991, Resolution: "2160p", Codec: "HEVC", HDR: "HDR", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Karate Kid 3 / The Karate Kid, Part III (1989)(CZ/EN)[2160p][HDR/DV][HEVC] = CSFD 33%", Info{Title: "Karate Kid 3", OriginalTitle: "The Karate Kid, Part III", Year: 1989, Resolution: "2160p", Codec: "HEVC", HDR: "HDR/DV", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Lilo a Stitch / Lilo & Stitch (2025)(CZ)[1080p][CAM] = CSFD 74%", Info{Title: "Lilo a Stitch", OriginalTitle: "Lilo & Stitch", Year: 2025, Resolution: "1080p", Source: "CAM", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Ako si vycvičiť draka / How to Train Your Dragon (2025)(SK/EN)[1080p][CAM] = CSFD 87%", Info{Title: "Ako si vycvičiť draka", OriginalTitle: "How to Train Your Dragon", Year: 2025, Resolution: "1080p", Source: "CAM", Languages: []string{"SK", "EN"}, Dubbed: true}},
+		{"28 let poté / 28 Years Later (2025)[KINO+V2][CAM][HEVC]  = CSFD 77%", Info{Title: "28 let poté", OriginalTitle: "28 Years Later", Year: 2025, Source: "CAM", Codec: "HEVC"}},
+		{"F1 The Movie 2025 1080p HDTS x264-RGB = CSFD 84%", Info{Title: "F1 The Movie", Year: 2025, Resolution: "1080p", Source: "TS", Codec: "H.264", Group: "RGB"}},
+		{"The Life Of Chuck 2024 1080p HDTS", Info{Title: "The Life Of Chuck", Year: 2024, Resolution: "1080p", Source: "TS"}},
+		{"Labková patrola: Letecká záchrana / Paw Patrol: Jet To The Rescue (2020)(SK)[720p][TvRip][HEVC] = CSFD 62%", Info{Title: "Labková patrola: Letecká záchrana", OriginalTitle: "Paw Patrol: Jet To The Rescue", Year: 2020, Resolution: "720p", Source: "TVRip", Codec: "HEVC", Languages: []string{"SK"}, Dubbed: true}},
+		{"Uuups! Dobrodružství Pokračuje... / Ooops! The Adventure Continues (2020)(CZ)[WebRip] = CSFD 61%", Info{Title: "Uuups! Dobrodružství Pokračuje", OriginalTitle: "Ooops! The Adventure Continues", Year: 2020, Source: "WEBRip", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Pokoj na koštěti / Room on the Broom (2012)(CZ) = CSFD 77%", Info{Title: "Pokoj na koštěti", OriginalTitle: "Room on the Broom", Year: 2012, Languages: []string{"CZ"}, Dubbed: true}},
+		{"Amatér / The Amateur (2025)[2160p][WEB-DL][DV/HDR10][HEVC] = CSFD 68%", Info{Title: "Amatér", OriginalTitle: "The Amateur", Year: 2025, Resolution: "2160p", Source: "WEB-DL", Codec: "HEVC", HDR: "HDR10/DV"}},
+		{"Dokud nás smrt nerozdělí - Burn Burn Burn (2015) [x265][1080p][EN] = CSFD 69%", Info{Title: "Dokud nás smrt nerozdělí - Burn Burn Burn", Year: 2015, Resolution: "1080p", Codec: "HEVC", Languages: []string{"EN"}}},
+		{"Smrt v Benátkách - Morte a Venezia - Death in Venice (1971)(EN)(FullHD)(HEVC) = CSFD 76%", Info{Title: "Smrt v Benátkách - Morte a Venezia - Death in Venice", Year: 1971, Resolution: "1080p", Codec: "HEVC", Languages: []string{"EN"}}},
+		{"Lost Highway (1997)(EN/IT)[2160p] US.4K.HDR.DV = CSFD 82%", Info{Title: "Lost Highway", Year: 1997, Resolution: "2160p", HDR: "HDR/DV", Languages: []string{"EN", "IT"}}},
+		{"Moc / Hatalom [2023][WEB-DL][HEVC][1080p](HU/PL)  = CSFD 55%", Info{Title: "Moc", OriginalTitle: "Hatalom", Year: 2023, Resolution: "1080p", Source: "WEB-DL", Codec: "HEVC", Languages: []string{"HU", "PL"}}},
+		{"Vyléčení / The Cured (2017)[WEB-DL][HEVC]= CSFD 50%", Info{Title: "Vyléčení", OriginalTitle: "The Cured", Year: 2017, Source: "WEB-DL", Codec: "HEVC"}},
+		{"Thunderbolts* (2025)[1080p][WEB-DL] = CSFD 79%", Info{Title: "Thunderbolts*", Year: 2025, Resolution: "1080p", Source: "WEB-DL"}},
+		{"Neúplatní / The Untouchables (1987)(CZ/SK/EN)[1080p][REMUX] = CSFD 85%", Info{Title: "Neúplatní", OriginalTitle: "The Untouchables", Year: 1987, Resolution: "1080p", Source: "BluRay", Languages: []string{"CZ", "SK", "EN"}, Dubbed: true}},
+		{"Twilight sága: Úsvit - 2. čast  / The Twilight Saga: Breaking Dawn - Part 2 (2012)(SK/EN)[1080p][WEB-DL] = CSFD 55%", Info{Title: "Twilight sága: Úsvit - 2. čast", OriginalTitle: "The Twilight Saga: Breaking Dawn - Part 2", Year: 2012, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"SK", "EN"}, Dubbed: true}},
+		{"Den, kdy se zastavila Země / The Day the Earth Stood Still (2008)(CZ/EN)[1080p] = CSFD 61%", Info{Title: "Den, kdy se zastavila Země", OriginalTitle: "The Day the Earth Stood Still", Year: 2008, Resolution: "1080p", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"The Elder Scrolls III: Morrowind – Game Of The Year Edition (2002) [GOG] [DODI Repack]", Info{Title: "The Elder Scrolls III: Morrowind – Game Of The Year Edition", Year: 2002, Group: "DODI"}},
+		{"theHunter Call of the Wild Alberta Hunting Preserve (CZ)-TENOKE", Info{Title: "theHunter Call of the Wild Alberta Hunting Preserve", Languages: []string{"CZ"}, Dubbed: true, Group: "TENOKE"}},
+		{"Call to Arms Gates of Hell Ostfront v1.052.0-P2P", Info{Title: "Call to Arms Gates of Hell Ostfront v1.052.0", Group: "P2P"}},
+		{"Backpack Battles (v1.0.1)", Info{Title: "Backpack Battles"}},
+		{"Vampire: The Masquerade - Redemption v1.1.v4 (2000) [GOG]", Info{Title: "Vampire: The Masquerade - Redemption v1.1.v4", Year: 2000}},
+		{"Foundation v1.10.3.10 (CZ)", Info{Title: "Foundation v1.10.3.10", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Pro Cycling Manager 25 (v1.1.2.415, MULTi9)[FitGirl Repack]", Info{Title: "Pro Cycling Manager 25", Group: "FitGirl"}},
+		{"CityDriver (2023) [RUNE]", Info{Title: "CityDriver", Year: 2023}},
+		{"Survive the Fall v1.1.1.2427-P2P", Info{Title: "Survive the Fall v1.1.1.2427", Group: "P2P"}},
+		{"KLAXON Rock - Jednim vrzem....druhym vrzem (1998)", Info{Title: "KLAXON Rock - Jednim vrzem....druhym vrzem", Year: 1998}},
+		{"EXTRA BAND - Full Of Love (2025)", Info{Title: "EXTRA BAND - Full Of Love", Year: 2025}},
+		{"DRIFTMOON - Transmission The Temple Of Time (Netherland 2025)[MP4 1080P]", Info{Title: "DRIFTMOON - Transmission The Temple Of Time", Year: 2025, Resolution: "1080p"}},
+		{"Koncert Karla Gotta / Karel Gott a jeho hosté - Pražská Lucerna (2000)(CZ)[720p][TvRip][HEVC] = CSFD 59%", Info{Title: "Koncert Karla Gotta", OriginalTitle: "Karel Gott a jeho hosté - Pražská Lucerna", Year: 2000, Resolution: "720p", Source: "TVRip", Codec: "HEVC", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Mirai - Turné 2021 (koncert) [1080p] [WEB-DL]", Info{Title: "Mirai - Turné", Year: 2021, Resolution: "1080p", Source: "WEB-DL"}},
+		{"Richard Muller - Koncert Lucerna Praha - 16.12.2001 (2003)[DVD-9]", Info{Title: "Richard Muller - Koncert Lucerna Praha - 16.12.2001", Year: 2003, Source: "DVD"}},
+		{"Ultra Music Festival 2024 - UltraLive (1440p)", Info{Title: "Ultra Music Festival", Year: 2024, Resolution: "1440p"}},
+		{"Blade - June 2025 (ENG)", Info{Title: "Blade - June", Year: 2025, Languages: []string{"EN"}}},
+		{"Dominik Dán - Podaj prst (2025)(SK)", Info{Title: "Dominik Dán - Podaj prst", Year: 2025, Languages: []string{"SK"}, Dubbed: true}},
+		{"Box - Deontay Wilder vs. Tyrrell Anthony Herndon", Info{Title: "Box - Deontay Wilder vs. Tyrrell Anthony Herndon"}},
+		{"SkyMed S03 (CZ/EN)[Web-DL][1080p] = CSFD 46%", Info{Title: "SkyMed", Season: 3, SeasonTo: 3, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Země nikoho / No Man's Land 1.-2 serie (2020-2025)(CZ)[WebRip][1080p][HEVC] = CSFD 81%", Info{Title: "Země nikoho", OriginalTitle: "No Man's Land", Year: 2020, Season: 1, SeasonTo: 2, Resolution: "1080p", Source: "WEBRip", Codec: "HEVC", Languages: []string{"CZ"}, Dubbed: true}},
+		{"The Walking Dead: Dead City S02E08 (CZ/EN)[WEB-DL][1080p] = CSFD 71%", Info{Title: "The Walking Dead: Dead City", Season: 2, SeasonTo: 2, EpisodeFrom: 8, EpisodeTo: 8, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Medvěd / The Bear 4. serie (2025)(CZ/EN)[1080p][WEB-DL] = CSFD 84%", Info{Title: "Medvěd", OriginalTitle: "The Bear", Year: 2025, Season: 4, SeasonTo: 4, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Unabomber / Manhunt - Unabomber S01 (CZ/EN)[1080p][WEB-DL] = CSFD 84%", Info{Title: "Unabomber", OriginalTitle: "Manhunt - Unabomber", Season: 1, SeasonTo: 1, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Odpočet / Countdown S01E01-E03 (EN)[WEB-DL][1080p]", Info{Title: "Odpočet", OriginalTitle: "Countdown", Season: 1, SeasonTo: 1, EpisodeFrom: 1, EpisodeTo: 3, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"EN"}}},
+		{"The Grand Tour S03E14 - Pohřeb Fordu (2019)(CZ)[WEB-DL][1080p] = CSFD 92%", Info{Title: "The Grand Tour", Year: 2019, Season: 3, SeasonTo: 3, EpisodeFrom: 14, EpisodeTo: 14, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Noc v archíve S17E11 - Jún v roku 1995 (2025)(SK)[720p][TVRip] = CSFD 74%", Info{Title: "Noc v archíve", Year: 2025, Season: 17, SeasonTo: 17, EpisodeFrom: 11, EpisodeTo: 11, Resolution: "720p", Source: "TVRip", Languages: []string{"SK"}, Dubbed: true}},
+		{"Ruža pre nevestu S03E13 - Speciál (2025)(SK)[WEB-DL][1080p] = CSFD 35%", Info{Title: "Ruža pre nevestu", Year: 2025, Season: 3, SeasonTo: 3, EpisodeFrom: 13, EpisodeTo: 13, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"SK"}, Dubbed: true}},
+		{"Party Shore Slovensko S01E01 (SK)[WEB-DL][1080p]", Info{Title: "Party Shore Slovensko", Season: 1, SeasonTo: 1, EpisodeFrom: 1, EpisodeTo: 1, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"SK"}, Dubbed: true}},
+		{"Top Gear: Polární speciál / Top Gear: Polar Special (2007)(EN/CZ)[HDTV][1080p] = CSFD 92%", Info{Title: "Top Gear: Polární speciál", OriginalTitle: "Top Gear: Polar Special", Year: 2007, Resolution: "1080p", Source: "HDTV", Languages: []string{"EN", "CZ"}, Dubbed: true}},
+		{"Smrtihlav / Dark City (1998)(Theatrical Cut)(CZ/EN)[2160p][Remux][HEVC] = CSFD 75%", Info{Title: "Smrtihlav", OriginalTitle: "Dark City", Year: 1998, Resolution: "2160p", Source: "BluRay", Codec: "HEVC", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Výplata / Paycheck (2003)(CZ/SK/EN)[2160p][WEB-DL][HDR10][HEVC] = CSFD 69%", Info{Title: "Výplata", OriginalTitle: "Paycheck", Year: 2003, Resolution: "2160p", Source: "WEB-DL", Codec: "HEVC", HDR: "HDR10", Languages: []string{"CZ", "SK", "EN"}, Dubbed: true}},
+		{"Freddy versus Jason / Freddy vs. Jason (CZ/EN)(2003)[2160p] = CSFD 63%", Info{Title: "Freddy versus Jason", OriginalTitle: "Freddy vs. Jason", Year: 2003, Resolution: "2160p", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"300: Bitva u Thermopyl / 300 (2006)(CZ/EN)[1080p][REMUX] = CSFD 78%", Info{Title: "300: Bitva u Thermopyl", OriginalTitle: "300", Year: 2006, Resolution: "1080p", Source: "BluRay", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Poslední pevnost / The Last Castle (2001)(CZ/EN)[2160p][HDR/DV][HEVC]", Info{Title: "Poslední pevnost", OriginalTitle: "The Last Castle", Year: 2001, Resolution: "2160p", Codec: "HEVC", HDR: "HDR/DV", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Jaat (2025)[WebRip][1080p]", Info{Title: "Jaat", Year: 2025, Resolution: "1080p", Source: "WEBRip"}},
+
+		// Seriály: díly, rozsahy dílů a balíky sérií v různých zápisech
+		{"Rick a Morty / Rick and Morty - S08E04 1080p (CZ/EN) = CSFD 90%", Info{Title: "Rick a Morty", OriginalTitle: "Rick and Morty", Season: 8, SeasonTo: 8, EpisodeFrom: 4, EpisodeTo: 4, Resolution: "1080p", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Bažináč / Swamp Thing [2019][S01][WEB-DL][HEVC][1080p][CZ/EN] = CSFD 64%", Info{Title: "Bažináč", OriginalTitle: "Swamp Thing", Year: 2019, Season: 1, SeasonTo: 1, Resolution: "1080p", Source: "WEB-DL", Codec: "HEVC", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"The Walking Dead: Dead City S02E06 (CZ/EN)[WEB-DL][1080p] = CSFD 71%", Info{Title: "The Walking Dead: Dead City", Season: 2, SeasonTo: 2, EpisodeFrom: 6, EpisodeTo: 6, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Vymítač / Egzorcysta S01-S03 1080p CZ tit. = CSFD 86%", Info{Title: "Vymítač", OriginalTitle: "Egzorcysta", Season: 1, SeasonTo: 3, Resolution: "1080p"}},
+		{"Sľub S01E101 (SK)[1080p][WEB-DL] = CSFD 52%", Info{Title: "Sľub", Season: 1, SeasonTo: 1, EpisodeFrom: 101, EpisodeTo: 101, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"SK"}, Dubbed: true}},
+		{"Přeživší / The Survivors S01 (CZ/EN)[WEB-DL][1080p] = CSFD 60%", Info{Title: "Přeživší", OriginalTitle: "The Survivors", Season: 1, SeasonTo: 1, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Hra na oliheň / Squid Game S03 (CZ)[1080p][WEB-DL] = CSFD 82%", Info{Title: "Hra na oliheň", OriginalTitle: "Squid Game", Season: 3, SeasonTo: 3, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Moloch S01 (CZ)[WebRip][1080p][HEVC] = CSFD 72%", Info{Title: "Moloch", Season: 1, SeasonTo: 1, Resolution: "1080p", Source: "WEBRip", Codec: "HEVC", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Signora Volpe S01 (CZ)[WEB-DL][1080p] = CSFD 52%", Info{Title: "Signora Volpe", Season: 1, SeasonTo: 1, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Ludwig: Šifra zločinu S01E03 Průvodkyně (CZ/EN)[WEB-DL][1080p] = CSFD 79%", Info{Title: "Ludwig: Šifra zločinu", Season: 1, SeasonTo: 1, EpisodeFrom: 3, EpisodeTo: 3, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Pakt [S01][S02][WEB-DL][HEVC][1080p](CZ/PL) = CSFD 69%", Info{Title: "Pakt", Season: 1, SeasonTo: 2, Resolution: "1080p", Source: "WEB-DL", Codec: "HEVC", Languages: []string{"CZ", "PL"}, Dubbed: true}},
+		{"Griffinovi / Family Guy S20 (CZ)[WEB-DL][1080p] = CSFD 75%", Info{Title: "Griffinovi", OriginalTitle: "Family Guy", Season: 20, SeasonTo: 20, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Řím / Rome [S01][S02][WEB-DL][HEVC][1080p][CZ-EN]  = CSFD 86%", Info{Title: "Řím", OriginalTitle: "Rome", Season: 1, SeasonTo: 2, Resolution: "1080p", Source: "WEB-DL", Codec: "HEVC", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Námořní vyšetřovací služba / NCIS: Naval Criminal Investigative Service S21E01 (CZ/EN)[WEB-DL][1080p] = CSFD 72%", Info{Title: "Námořní vyšetřovací služba", OriginalTitle: "NCIS: Naval Criminal Investigative Service", Season: 21, SeasonTo: 21, EpisodeFrom: 1, EpisodeTo: 1, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Má nemrtvá nadpřirozená přítelkyně / My Undead Yokai Girlfriend 1 serie (2024)[1080p][WebRip] = CSFD 78%", Info{Title: "Má nemrtvá nadpřirozená přítelkyně", OriginalTitle: "My Undead Yokai Girlfriend", Year: 2024, Season: 1, SeasonTo: 1, Resolution: "1080p", Source: "WEBRip"}},
+		{"Bora S01E02 (CZ)[WEB-DL][1080p] = CSFD 61%", Info{Title: "Bora", Season: 1, SeasonTo: 1, EpisodeFrom: 2, EpisodeTo: 2, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Vraždy v kraji S01E08 - Gastarbajtři (CZ)[WEB-DL][1080p] = CSFD 59%", Info{Title: "Vraždy v kraji", Season: 1, SeasonTo: 1, EpisodeFrom: 8, EpisodeTo: 8, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Bez milosti / Gwang-jang S01 (2025)[1080p][WEB-DL] = CSFD 85%", Info{Title: "Bez milosti", OriginalTitle: "Gwang-jang", Year: 2025, Season: 1, SeasonTo: 1, Resolution: "1080p", Source: "WEB-DL"}},
+		{"Plastická chirurgie s. r. o. / Nip/Tuck S01 (CZ/EN)[1080p] = CSFD 61%", Info{Title: "Plastická chirurgie s. r. o", OriginalTitle: "Nip/Tuck", Season: 1, SeasonTo: 1, Resolution: "1080p", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Animal Kingdom - 3. serie (CZ)[WEB-DL][1080p] = CSFD 76%", Info{Title: "Animal Kingdom", Season: 3, SeasonTo: 3, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Ledova archa / Snowpiercer S01-S04 (CZ)[WebRip][1080p][HEVC] = CSFD 69%", Info{Title: "Ledova archa", OriginalTitle: "Snowpiercer", Season: 1, SeasonTo: 4, Resolution: "1080p", Source: "WEBRip", Codec: "HEVC", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Oddělení Q / Department Q S01 (CZ)[WebRip][1080p][HEVC] = CSFD 79%", Info{Title: "Oddělení Q", OriginalTitle: "Department Q", Season: 1, SeasonTo: 1, Resolution: "1080p", Source: "WEBRip", Codec: "HEVC", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Návštěvníci / Visitors (2022)(1-série)[WEB-DL][HEVC][1080p](CZ/EN)  = CSFD 48%", Info{Title: "Návštěvníci", OriginalTitle: "Visitors", Year: 2022, Season: 1, SeasonTo: 1, Resolution: "1080p", Source: "WEB-DL", Codec: "HEVC", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Prezident v poradi / Designated Survivor - seria 1-3 (2016-2019)(CZ)[HDTV][HEVC][720p] = CSFD 74%", Info{Title: "Prezident v poradi", OriginalTitle: "Designated Survivor", Year: 2016, Season: 1, SeasonTo: 3, Resolution: "720p", Source: "HDTV", Codec: "HEVC", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Toša a Tomek / Dziewczyna i Chlopak E01-E06 (1980)[1080p] = CSFD 68%", Info{Title: "Toša a Tomek", OriginalTitle: "Dziewczyna i Chlopak", Year: 1980, EpisodeFrom: 1, EpisodeTo: 6, Resolution: "1080p"}},
+		{"Mentalista / The Mentalist - 4. séria SK dabing 720p AI processed = CSFD 71%", Info{Title: "Mentalista", OriginalTitle: "The Mentalist", Season: 4, SeasonTo: 4, Resolution: "720p", Languages: []string{"SK"}, Dubbed: true}},
+		{"Happy Face S01 (CZ/EN)[WEB-DL][1080p] = CSFD 53%", Info{Title: "Happy Face", Season: 1, SeasonTo: 1, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"A jak to bylo dál... / And Just Like That... S03E02 (CZ/HU/POL)[1080p] = CSFD 53%", Info{Title: "A jak to bylo dál", OriginalTitle: "And Just Like That", Season: 3, SeasonTo: 3, EpisodeFrom: 2, EpisodeTo: 2, Resolution: "1080p", Languages: []string{"CZ", "HU", "PL"}, Dubbed: true}},
+		{"Zalez do spacáku S01 (CZ)[WEB-DL][1080p] = CSFD 30%", Info{Title: "Zalez do spacáku", Season: 1, SeasonTo: 1, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Zelenáč / The Rookie S07E04 (CZ/EN)[WEB-DL][1080p] = CSFD 78%", Info{Title: "Zelenáč", OriginalTitle: "The Rookie", Season: 7, SeasonTo: 7, EpisodeFrom: 4, EpisodeTo: 4, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"The Walking Dead: Dead City S01-S02 (CZ)[WebRip][1080p][HEVC] = CSFD 71%", Info{Title: "The Walking Dead: Dead City", Season: 1, SeasonTo: 2, Resolution: "1080p", Source: "WEBRip", Codec: "HEVC", Languages: []string{"CZ"}, Dubbed: true}},
+
+		// Čísla v názvech, která nejsou rok vydání ani série (2025x06, x32x64, 4K na začátku názvu, "3:15", "Transformers 3")
+		{"Farma Česko - 2025x06 (CZ)[WEB-DL][1080p] = CSFD 17%", Info{Title: "Farma Česko", Year: 2025, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Total Commander 11.55 CZ + SK (x32x64) portable", Info{Title: "Total Commander 11.55 CZ + SK"}},
+		{"RarmaRadio Pro 2.77.3 CZ (x32x64) portable", Info{Title: "RarmaRadio Pro 2.77.3 CZ"}},
+		{"4K Video Downloader Plus 25.1.2.0198 CZ (x32x64) portable", Info{Title: "4K Video Downloader Plus 25.1.2.0198 CZ"}},
+		{"4K YouTube to MP3 - 25.1.2.0198 CZ (x32x64) portable", Info{Title: "4K YouTube to MP3 - 25.1.2.0198 CZ"}},
+		{"VA - BRAVO Hits 2025 (04.06.2025)", Info{Title: "VA - BRAVO Hits", Year: 2025}},
+		{"System Shock 2: 25th Anniversary Remaster (2025) [GOG]", Info{Title: "System Shock 2: 25th Anniversary Remaster", Year: 2025}},
+		{"Hvězdná brána / Stargate 1994 EXTENDED D.C. BluRay 4K Ai H265 = CSFD 76%", Info{Title: "Hvězdná brána", OriginalTitle: "Stargate", Year: 1994, Resolution: "2160p", Source: "BluRay", Codec: "HEVC"}},
+		{"Dokonalá loupež 2 / Den of Thieves 2: Pantera (2025)(CZ)[1080p] = CSFD 59%", Info{Title: "Dokonalá loupež 2", OriginalTitle: "Den of Thieves 2: Pantera", Year: 2025, Resolution: "1080p", Languages: []string{"CZ"}, Dubbed: true}},
+		{"VA - Black Hole Trance Music 2025 - 06", Info{Title: "VA - Black Hole Trance Music", Year: 2025}},
+		{"3:15 zemřeš / The Amityville Horror (2005)(CZ/EN)[2160p][HDR/DV] = CSFD 59%", Info{Title: "3:15 zemřeš", OriginalTitle: "The Amityville Horror", Year: 2005, Resolution: "2160p", HDR: "HDR/DV", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"50 let Star Treku / 50 Years of Star Trek (2016)[1080p][HEVC]", Info{Title: "50 let Star Treku", OriginalTitle: "50 Years of Star Trek", Year: 2016, Resolution: "1080p", Codec: "HEVC"}},
+		{"Iron Maiden - Edward The Great (Remastered 2005 EU,EMI) (2002)", Info{Title: "Iron Maiden - Edward The Great", Year: 2002}},
+		{"Transformers 3: Odvrácená strana Měsíce / Transformers: Dark of the Moon (2011)(CZ/EN)[2160p][HDR/DV][HEVC] = CSFD 63%", Info{Title: "Transformers 3: Odvrácená strana Měsíce", OriginalTitle: "Transformers: Dark of the Moon", Year: 2011, Resolution: "2160p", Codec: "HEVC", HDR: "HDR/DV", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Victoria 3: Grand Edition (v1.9.0 Lady Grey 15 DLCs/Bonuses Windows 7 Fix, MULTi11) [FitGirl Repack]", Info{Title: "Victoria 3: Grand Edition", Group: "FitGirl"}},
+		{"7 Days to Die v2.0 Storm's Brewing Experimental (2024)", Info{Title: "7 Days to Die v2.0 Storm's Brewing Experimental", Year: 2024}},
+		{"Monument Valley 2: Panoramic Edition (2022)", Info{Title: "Monument Valley 2: Panoramic Edition", Year: 2022}},
+		{"300: Vzostup impéria / 300: Rise of an Empire (2014)(SK/EN)[BDRip][1080p] = CSFD 63%", Info{Title: "300: Vzostup impéria", OriginalTitle: "300: Rise of an Empire", Year: 2014, Resolution: "1080p", Source: "BluRay", Languages: []string{"SK", "EN"}, Dubbed: true}},
+		{"Formule 1 - Velká cena MSC Cruises Rakouska 2025 + studio - web_rip 1080p", Info{Title: "Formule 1 - Velká cena MSC Cruises Rakouska", Year: 2025, Resolution: "1080p"}},
+		{"Otázky pro dvě ženy (1985)(CZ)[WebRip] = CSFD 70%", Info{Title: "Otázky pro dvě ženy", Year: 1985, Source: "WEBRip", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Hvězdná brána: Atlantida / Stargate: Atlantis 1.-5 serie (2004)(CZ/EN)[1080p][HEVC] = CSFD 78%", Info{Title: "Hvězdná brána: Atlantida", OriginalTitle: "Stargate: Atlantis", Year: 2004, Season: 1, SeasonTo: 5, Resolution: "1080p", Codec: "HEVC", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Řím / Rome S01-S02 (CZ)[WebRip][1080p][HEVC] = CSFD 86%", Info{Title: "Řím", OriginalTitle: "Rome", Season: 1, SeasonTo: 2, Resolution: "1080p", Source: "WEBRip", Codec: "HEVC", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Hra na olihen / Ojingeo geim / Squid Game S01-S03 (CZ)[WebRip][1080p][HEVC] = CSFD 82%", Info{Title: "Hra na olihen", OriginalTitle: "Squid Game", Season: 1, SeasonTo: 3, Resolution: "1080p", Source: "WEBRip", Codec: "HEVC", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Neporazitelný / Invincible - 1-3 série (CZ/EN)[1080p] = CSFD 89%", Info{Title: "Neporazitelný", OriginalTitle: "Invincible", Season: 1, SeasonTo: 3, Resolution: "1080p", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Malý Princ / Le Petit Prince 1.-2 serie (CZ) = CSFD 35%", Info{Title: "Malý Princ", OriginalTitle: "Le Petit Prince", Season: 1, SeasonTo: 2, Languages: []string{"CZ"}, Dubbed: true}},
+		{"Hranice nemožného / Fringe 1.-2 serie (2008-2009)(CZ)[720p]  = CSFD 80%", Info{Title: "Hranice nemožného", OriginalTitle: "Fringe", Year: 2008, Season: 1, SeasonTo: 2, Resolution: "720p", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Superman a Lois / Superman and Lois 1.-4 serie (2021–2024)[1080p][WEB-DL][HEVC] = CSFD 75%", Info{Title: "Superman a Lois", OriginalTitle: "Superman and Lois", Year: 2021, Season: 1, SeasonTo: 4, Resolution: "1080p", Source: "WEB-DL", Codec: "HEVC"}},
+		{"City of God: The Fight Rages On / Cidade de Deus: A Luta Não Para 1. serie (2024)(CZ/EN)[2160p][WEB-DL][HEVC] = CSFD 71%", Info{Title: "City of God: The Fight Rages On", OriginalTitle: "Cidade de Deus: A Luta Não Para", Year: 2024, Season: 1, SeasonTo: 1, Resolution: "2160p", Source: "WEB-DL", Codec: "HEVC", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"John Flanagan - série: Hraničářův učeň 17 - Arazanini vlci (2024)", Info{Title: "John Flanagan - série: Hraničářův učeň 17 - Arazanini vlci", Year: 2024}},
+		{"Great War Aviation Centennial Series [Aeronaut]", Info{Title: "Great War Aviation Centennial Series"}},
+		{"Tohle město je naše / This City Is Ours (2025)(1-série)[WEB-DL][HEVC][1080p](CZ/EN)  = CSFD 68%", Info{Title: "Tohle město je naše", OriginalTitle: "This City Is Ours", Year: 2025, Season: 1, SeasonTo: 1, Resolution: "1080p", Source: "WEB-DL", Codec: "HEVC", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Noční můra v Elm Street 1 (1984) UHDR+DV cz en.mkv = CSFD 75%", Info{Title: "Noční můra v Elm Street 1", Year: 1984, HDR: "DV"}},
+		{"Nahoru po schodišti dolů band 2001 Svinska pržola", Info{Title: "Nahoru po schodišti dolů band", Year: 2001}},
+		{"Giro di vita(CZ)(2001)[TVRip] = CSFD 43%", Info{Title: "Giro di vita", Year: 2001, Source: "TVRip", Languages: []string{"CZ"}, Dubbed: true}},
+
+		// Filmy
+		{"Karate Kid / The Karate Kid Remastered (1984)(CZ/EN)[1080p][HEVC] = CSFD 65%", Info{Title: "Karate Kid", OriginalTitle: "The Karate Kid Remastered", Year: 1984, Resolution: "1080p", Codec: "HEVC", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Nezvratný osud: Pokrevní linie / Final Destination: Bloodlines (2025)[2160p][WEB-DL] = CSFD 73%", Info{Title: "Nezvratný osud: Pokrevní linie", OriginalTitle: "Final Destination: Bloodlines", Year: 2025, Resolution: "2160p", Source: "WEB-DL"}},
+		{"Karate Kid / The Karate Kid (1984)(CZ/EN)[2160p][HDR/DV][HEVC] = CSFD 65%", Info{Title: "Karate Kid", OriginalTitle: "The Karate Kid", Year: 1984, Resolution: "2160p", Codec: "HEVC", HDR: "HDR/DV", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Jablicko z laciného kraje(CZ)(1976)[WebRip] = CSFD 57%", Info{Title: "Jablicko z laciného kraje", Year: 1976, Source: "WEBRip", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Resident Evil: Apokalypsa (2004)(CZ/EN)[2160p][HDR][HEVC] = CSFD 59%", Info{Title: "Resident Evil: Apokalypsa", Year: 2004, Resolution: "2160p", Codec: "HEVC", HDR: "HDR", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Svadobné trapasy / Dokonalá partie / The Wedding Date (2005)(CZ/SK/EN)[1080p] = CSFD 57%", Info{Title: "Svadobné trapasy", OriginalTitle: "The Wedding Date", Year: 2005, Resolution: "1080p", Languages: []string{"CZ", "SK", "EN"}, Dubbed: true}},
+		{"Les / Wald (2023)(CZ/DE)[1080p][WEB-DL] = CSFD 50%", Info{Title: "Les", OriginalTitle: "Wald", Year: 2023, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ", "DE"}, Dubbed: true}},
+		{"Transformers: Poslední rytíř / Transformers: The Last Knight (2017)(CZ/EN)[2160p][HDR/DV][HEVC] = CSFD 49%", Info{Title: "Transformers: Poslední rytíř", OriginalTitle: "Transformers: The Last Knight", Year: 2017, Resolution: "2160p", Codec: "HEVC", HDR: "HDR/DV", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Záhada Blair Witch / The Blair Witch Project (1999)(CZ/SK/EN)[1080p][Remux] = CSFD 70%", Info{Title: "Záhada Blair Witch", OriginalTitle: "The Blair Witch Project", Year: 1999, Resolution: "1080p", Source: "BluRay", Languages: []string{"CZ", "SK", "EN"}, Dubbed: true}},
+		{"Žiješ jenom dvakrát / You Only Live Twice (1967)(CZ/EN)[2160p][HDR/DV][HEVC] = CSFD 77%", Info{Title: "Žiješ jenom dvakrát", OriginalTitle: "You Only Live Twice", Year: 1967, Resolution: "2160p", Codec: "HEVC", HDR: "HDR/DV", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Prometheus (2012)(CZ/EN)[2160p][HDR/DV][HEVC] = CSFD 66%", Info{Title: "Prometheus", Year: 2012, Resolution: "2160p", Codec: "HEVC", HDR: "HDR/DV", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Do nitra Planety opic / Beneath the Planet of the Apes (1970)(CZ/EN)[AIUpscale][2160p][HDR+/DV][HEVC] = CSFD 55%", Info{Title: "Do nitra Planety opic", OriginalTitle: "Beneath the Planet of the Apes", Year: 1970, Resolution: "2160p", Codec: "HEVC", HDR: "HDR10+/DV", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Sadako Vs. Kayako / 貞子vs伽椰子 (2016)[1080p] = CSFD 46%", Info{Title: "Sadako Vs. Kayako", OriginalTitle: "貞子vs伽椰子", Year: 2016, Resolution: "1080p"}},
+		{"Abigail (2024)(CZ/EN)[2160p][HDR/DV][HEVC] = CSFD 63%", Info{Title: "Abigail", Year: 2024, Resolution: "2160p", Codec: "HEVC", HDR: "HDR/DV", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Osamelý pomstiteľ  / A Man Apart (2003)(SK)[BdRip][HEVC][1080p] = CSFD 57%", Info{Title: "Osamelý pomstiteľ", OriginalTitle: "A Man Apart", Year: 2003, Resolution: "1080p", Source: "BluRay", Codec: "HEVC", Languages: []string{"SK"}, Dubbed: true}},
+		{"Zloba - Královna černé magie / Maleficent (2014)(CZ/EN)[2160p][HDR/DV][HEVC] = CSFD 73%", Info{Title: "Zloba - Královna černé magie", OriginalTitle: "Maleficent", Year: 2014, Resolution: "2160p", Codec: "HEVC", HDR: "HDR/DV", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Resident Evil: Raccoon City (2021)(CZ/EN)[2160p][HDR/DV][HEVC] = CSFD 46%", Info{Title: "Resident Evil: Raccoon City", Year: 2021, Resolution: "2160p", Codec: "HEVC", HDR: "HDR/DV", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Podřízenost / Subservience (2024)(CZ/EN)[1080p][WEB-DL] = CSFD 53%", Info{Title: "Podřízenost", OriginalTitle: "Subservience", Year: 2024, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Masameer Junior (2025)(CZ)[1080p][WEB-DL] = CSFD 50%", Info{Title: "Masameer Junior", Year: 2025, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Fotři jsou lotři / Little Fockers (2010)(CZ/EN)[1080p][HEVC] = CSFD 56%", Info{Title: "Fotři jsou lotři", OriginalTitle: "Little Fockers", Year: 2010, Resolution: "1080p", Codec: "HEVC", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Falšovateľ / Der Passfälscher (2022)(DE)[TVRip][HEVC][720p] = CSFD 59%", Info{Title: "Falšovateľ", OriginalTitle: "Der Passfälscher", Year: 2022, Resolution: "720p", Source: "TVRip", Codec: "HEVC", Languages: []string{"DE"}}},
+		{"Shirley Valentinová / Shirley Valentine (CZ/EN)(1989)[1080p][HEVC] = CSFD 81%", Info{Title: "Shirley Valentinová", OriginalTitle: "Shirley Valentine", Year: 1989, Resolution: "1080p", Codec: "HEVC", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Král Lávra (CZ)(1950)[WebRip] = CSFD 78%", Info{Title: "Král Lávra", Year: 1950, Source: "WEBRip", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Zelená míle / The Green Mile (1999)(CZ/EN)[2160p][HDR/DV][HEVC] = CSFD 93%", Info{Title: "Zelená míle", OriginalTitle: "The Green Mile", Year: 1999, Resolution: "2160p", Codec: "HEVC", HDR: "HDR/DV", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Surfař  / The Surfer (2024)[2160p] = CSFD 70%", Info{Title: "Surfař", OriginalTitle: "The Surfer", Year: 2024, Resolution: "2160p"}},
+		{"Charlieho andílci / Charlie's Angels (2019)(CZ/EN)[2160p][HDR][HEVC] = CSFD 42%", Info{Title: "Charlieho andílci", OriginalTitle: "Charlie's Angels", Year: 2019, Resolution: "2160p", Codec: "HEVC", HDR: "HDR", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Rocketman (2019)(CZ/EN)[2160p][HDR][HEVC] = CSFD 73%", Info{Title: "Rocketman", Year: 2019, Resolution: "2160p", Codec: "HEVC", HDR: "HDR", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+		{"Den nezavislosti / Independence Day (1996)(CZ/EN)[2160p][HDR][HEVC] = CSFD 75%", Info{Title: "Den nezavislosti", OriginalTitle: "Independence Day", Year: 1996, Resolution: "2160p", Codec: "HEVC", HDR: "HDR", Languages: []string{"CZ", "EN"}, Dubbed: true}},
+
+		// Hudba: rok v názvu alba nebo kompilace
+		{"Linkin Park - Lost Demos (2023)[FLAC]", Info{Title: "Linkin Park - Lost Demos", Year: 2023}},
+		{"KABAT - Live Radovesnice II (1990)", Info{Title: "KABAT - Live Radovesnice II", Year: 1990}},
+		{"Heaven Shall Burn - Heimat - 2025, Hi-Res", Info{Title: "Heaven Shall Burn - Heimat", Year: 2025}},
+		{"Various Artist - Metal Hard Rock Covers Vol.9 (2005)", Info{Title: "Various Artist - Metal Hard Rock Covers Vol.9", Year: 2005}},
+		{"Vivaldianno 2015 - Město zrcadel (2015)(CZ)[WEB-DL][720p] = CSFD 73%", Info{Title: "Vivaldianno 2015 - Město zrcadel", Year: 2015, Resolution: "720p", Source: "WEB-DL", Languages: []string{"CZ"}, Dubbed: true}},
+		{"VA - House Clubhits - Summer Edition 2025 (2025)", Info{Title: "VA - House Clubhits - Summer Edition 2025", Year: 2025}},
+		{"Heaven Shall Burn - Heimat - 2025, MP3", Info{Title: "Heaven Shall Burn - Heimat", Year: 2025}},
+		{"Mirai - Turné 2021 (koncert)\u00a0[1080p]\u00a0[WEB-DL]", Info{Title: "Mirai - Turné", Year: 2021, Resolution: "1080p", Source: "WEB-DL"}},
+		{"Gate Crasher - Chocolate Rabbit (2006)", Info{Title: "Gate Crasher - Chocolate Rabbit", Year: 2006}},
+		{"Alestorm - The Thunderfist Chronicles (Deluxe Version) [3 CD] - 2025, MP3", Info{Title: "Alestorm - The Thunderfist Chronicles", Year: 2025}},
+		{"Noc na Karlštejně (Original soundtrack / Score) [1975]", Info{Title: "Noc na Karlštejně", Year: 1975}},
+		{"Monika Načeva 2003 Fontanela", Info{Title: "Monika Načeva", Year: 2003}},
+		{"KLAXON Rock - studio Cs.rozhlasu Hradec Kralove (1987 - 1988)", Info{Title: "KLAXON Rock - studio Cs.rozhlasu Hradec Kralove", Year: 1987}},
+		{"Mumuland Orchestra - Mumuland Party Mix (2002)[FLAC]", Info{Title: "Mumuland Orchestra - Mumuland Party Mix", Year: 2002}},
+		{"VA - NRJ Summer Hits Only (3CD) - (2025)", Info{Title: "VA - NRJ Summer Hits Only", Year: 2025}},
+		{"LOTR 3 - The Return of the King (Original soundtrack / Score) [2003]", Info{Title: "LOTR 3 - The Return of the King", Year: 2003}},
+		{"LOTR 2 - The Two Towers (Original soundtrack / Score)[2002]", Info{Title: "LOTR 2 - The Two Towers", Year: 2002}},
+
+		// Hry a programy: verze a buildy nejsou rok ani díl
+		{"Las Vegas Nights cz/eng [java emulator]", Info{Title: "Las Vegas Nights cz/eng"}},
+		{"ArchiCAD 28.2.0 Build 5000 CZ update only (x64)", Info{Title: "ArchiCAD 28.2.0 Build 5000 CZ update only"}},
+		{"Broken Arrow (2025) [P2P]", Info{Title: "Broken Arrow", Year: 2025}},
+		{"System Shock 2: 25th Anniversary Remaster (2025)\u00a0[RUNE]", Info{Title: "System Shock 2: 25th Anniversary Remaster", Year: 2025}},
+		{"OUTBRK v0.0.3.593 (2024)", Info{Title: "OUTBRK v0.0.3.593", Year: 2024}},
+		{"Beholder: Conductor (2025, Adventure) (1.0.4.278) [GOG]", Info{Title: "Beholder: Conductor", Year: 2025}},
+		{"Greedland Build 18822531 (2023)", Info{Title: "Greedland Build 18822531", Year: 2023}},
+		{"MateEngine v1.8.8 (2025)", Info{Title: "MateEngine v1.8.8", Year: 2025}},
+		{"Schedule I v0.3.6f2 Open Beta (2025)", Info{Title: "Schedule I v0.3.6f2 Open Beta", Year: 2025}},
+		{"Railway Empire 2 v1.7.0.64099 + 10xDLC [RUNE]", Info{Title: "Railway Empire 2 v1.7.0.64099 + 10xDLC"}},
+		{"Lies of P: Overture Bundle, v1.8.0.0 + 4 DLCs/Bonuses + Windows 7 Fix [FitGirl Repack]", Info{Title: "Lies of P: Overture Bundle, v1.8.0.0 + 4 DLCs/Bonuses + Windows 7 Fix", Group: "FitGirl"}},
+		{"ServiceIT: You can do IT Build 18875587 (2024)", Info{Title: "ServiceIT: You can do IT Build 18875587", Year: 2024}},
+		{"Windy.app - Enhanced forecast 79.0.2 build 801 [Pro]", Info{Title: "Windy.app - Enhanced forecast 79.0.2 build 801"}},
+		{"Six Days in Fallujah v0.4.3 Early Access", Info{Title: "Six Days in Fallujah v0.4.3 Early Access"}},
+		{"Alpha Response Early Access (2024)", Info{Title: "Alpha Response Early Access", Year: 2024}},
+		{"BeamNG Drive v0.36 (2015)", Info{Title: "BeamNG Drive v0.36", Year: 2015}},
+		{"Forza Motorsport Premium Edition v1.853.3921.0-P2P", Info{Title: "Forza Motorsport Premium Edition v1.853.3921.0", Group: "P2P"}},
+		{"House Builder: Pack and Punch Bundle (Build 16-06-2025 7 DLCs, MULTi35)[FitGirl Repack]", Info{Title: "House Builder: Pack and Punch Bundle", Year: 2025, Group: "FitGirl"}},
+		{"Netherworld Covenant (v0.6.9)", Info{Title: "Netherworld Covenant"}},
+		{"System Shock 2: 25th Anniversary Remaster (2025)\u00a0[GOG]", Info{Title: "System Shock 2: 25th Anniversary Remaster", Year: 2025}},
+		{"Call of Duty: Vanguard (v1.26 Campaign/Zombies Bonus OST, MULTi13)[FitGirl Repack]", Info{Title: "Call of Duty: Vanguard", Group: "FitGirl"}},
+		{"Supermarket Simulator v1.0.1 (2025)", Info{Title: "Supermarket Simulator v1.0.1", Year: 2025}},
+		{"Teardown v1.7.0 + 5xDLC (2022)\u00a0[RUNE]", Info{Title: "Teardown v1.7.0 + 5xDLC", Year: 2022}},
+
+		// Knihy, sport, TV pořady a dokumenty
+		{"Jaroslav Havlíček - Helimadoe (2023)", Info{Title: "Jaroslav Havlíček - Helimadoe", Year: 2023}},
+		{"Chip (07/2025)(CZ)", Info{Title: "Chip", Year: 2025, Languages: []string{"CZ"}, Dubbed: true}},
+		{"Bojovníci v odboji a ilegalite - vzpomínky partyzánky(CZ)(2021)[WebRip] = CSFD 76%", Info{Title: "Bojovníci v odboji a ilegalite - vzpomínky partyzánky", Year: 2021, Source: "WEBRip", Languages: []string{"CZ"}, Dubbed: true}},
+		{"OKTAGON 72 (CZ)[1080p]", Info{Title: "OKTAGON 72", Resolution: "1080p", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Farma Česko - 2025x05 (CZ)[WEB-DL][1080p] = CSFD 18%", Info{Title: "Farma Česko", Year: 2025, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Aeroplane Icons [pdf]", Info{Title: "Aeroplane Icons"}},
+		{"Kukang Movie: Příběh o outloních a lidech (2023)(CZ)[1080p][WEB-DL] = CSFD 50%", Info{Title: "Kukang Movie: Příběh o outloních a lidech", Year: 2023, Resolution: "1080p", Source: "WEB-DL", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Všechny Góly Mistrů 95 - mistrovských zásahů (CZ)(2025)[WebRip]", Info{Title: "Všechny Góly Mistrů 95 - mistrovských zásahů", Year: 2025, Source: "WEBRip", Languages: []string{"CZ"}, Dubbed: true}},
+		{"James Lovegrove - Sherlock Holmes a Labyrint smrti (2024)", Info{Title: "James Lovegrove - Sherlock Holmes a Labyrint smrti", Year: 2024}},
+		{"MasterClass - Aaron Franklin - Texas-Style BBQ", Info{Title: "MasterClass - Aaron Franklin - Texas-Style BBQ"}},
+		{"Majcini kluci (1987)(CZ)[WebRip] = CSFD 67%", Info{Title: "Majcini kluci", Year: 1987, Source: "WEBRip", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Kombinovaný velký slovník pro forenzní analýzu v IT", Info{Title: "Kombinovaný velký slovník pro forenzní analýzu v IT"}},
+		{"Ozzy Osbourne, na trůnu temnoty / Ozzy Osbourne: Throne of Darkness (2020)(CZ)[720p][TvRip] = CSFD 53%", Info{Title: "Ozzy Osbourne, na trůnu temnoty", OriginalTitle: "Ozzy Osbourne: Throne of Darkness", Year: 2020, Resolution: "720p", Source: "TVRip", Languages: []string{"CZ"}, Dubbed: true}},
+		{"F2 + F3 + Porsche - Rakousko (28.6. - 29.06.2025) [1080p] [50FPS]", Info{Title: "F2 + F3 + Porsche - Rakousko", Resolution: "1080p"}},
+		{"Dana Stabenow - Kate Shugaková (2022-2025)", Info{Title: "Dana Stabenow - Kate Shugaková", Year: 2022}},
+		{"Sbírka 430+ anglických knih [EPUB]", Info{Title: "Sbírka 430+ anglických knih"}},
+		{"Blahutová Ivana-Bílý kůň [PDF]", Info{Title: "Blahutová Ivana-Bílý kůň"}},
+		{"Celebrity Internetu (2025)(CZ)[WebRip] = CSFD 49%", Info{Title: "Celebrity Internetu", Year: 2025, Source: "WEBRip", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Nepríjemná pravda 2 / An Inconvenient Sequel: Truth to Power  (2017)(SK)[TvRip][HEVC][720p] = CSFD 70%", Info{Title: "Nepríjemná pravda 2", OriginalTitle: "An Inconvenient Sequel: Truth to Power", Year: 2017, Resolution: "720p", Source: "TVRip", Codec: "HEVC", Languages: []string{"SK"}, Dubbed: true}},
+		{"F1 - Kanada - Závod [15.06.2025]", Info{Title: "F1 - Kanada - Závod"}},
+		{"Revenant (Star Trek - Deep Space Nine) by Alex White (EN) [EPUB]", Info{Title: "Revenant", Languages: []string{"EN"}}},
+		{"F1 - Kanada - trénink 1 + trénink 2 [13.06.2025]", Info{Title: "F1 - Kanada - trénink 1 + trénink 2"}},
+		{"F1 - Rakousko - studio (29.06.2025) [1080p] [50FPS]", Info{Title: "F1 - Rakousko - studio", Resolution: "1080p"}},
+		{"Slovenské EPUB knihy (cca 750) do 2025-05", Info{Title: "Slovenské EPUB knihy", Year: 2025}},
+		{"Vilém Koubek - Posmrtná predace (2022)", Info{Title: "Vilém Koubek - Posmrtná predace", Year: 2022}},
+		{"F1 - Rakousko - trénink 3 + kvalifikace (28.06.2025)[1080p]", Info{Title: "F1 - Rakousko - trénink 3 + kvalifikace", Resolution: "1080p"}},
+		{"Slunce,seno,vesnice (2008)(CZ) = CSFD 71%", Info{Title: "Slunce,seno,vesnice", Year: 2008, Languages: []string{"CZ"}, Dubbed: true}},
+		{"F1 - Kanada - trénink 3 + kvalifikace [14.06.2025]", Info{Title: "F1 - Kanada - trénink 3 + kvalifikace"}},
+
+		// Konec rozsahu dílů nebo sérií není release group
+		{"Dexter S01-S08 (CZ)[1080p]", Info{Title: "Dexter", Season: 1, SeasonTo: 8, Resolution: "1080p", Languages: []string{"CZ"}, Dubbed: true}},
+		{"Ironheart S01E04-E06", Info{Title: "Ironheart", Season: 1, SeasonTo: 1, EpisodeFrom: 4, EpisodeTo: 6}},
+		// Scénový název bez mezer
+		{"Sinners.2025.2160p.WEB-DL.DV.HDR10+.HEVC-FLUX", Info{Title: "Sinners", Year: 2025, Resolution: "2160p", Source: "WEB-DL", Codec: "HEVC", HDR: "HDR10+/DV", Group: "FLUX"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Parse(tt.name); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse(%q)\n got: %+v\nwant: %+v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
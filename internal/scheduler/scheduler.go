@@ -0,0 +1,228 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Schedule určuje, kdy se úloha spustí
+type Schedule interface {
+	// Next vrátí první spuštění po started (začátek předchozího běhu)
+	Next(started time.Time) time.Time
+	String() string
+}
+
+// Every spouští úlohu v pevném intervalu od začátku předchozího běhu. Když
+// běh trvá déle než interval, další začne hned po něm (nesčítají se).
+type Every time.Duration
+
+func (e Every) Next(started time.Time) time.Time {
+	return started.Add(time.Duration(e))
+}
+
+func (e Every) String() string {
+	return "každých " + time.Duration(e).String()
+}
+
+// Window je denní časové okno, např. 02:00-05:00. Úloha se spustí jednou
+// za noc na začátku okna a podle End pozná, kdy má skončit.
+type Window struct {
+	Start    time.Duration // od půlnoci
+	End      time.Duration // od půlnoci; menší než Start = okno přes půlnoc
+	Location *time.Location
+}
+
+// ParseWindow přečte okno ve tvaru "02:00-05:00" v časovém pásmu loc
+func ParseWindow(spec string, loc *time.Location) (Window, error) {
+	startText, endText, ok := strings.Cut(spec, "-")
+	if !ok {
+		return Window{}, fmt.Errorf("invalid window %q, expected HH:MM-HH:MM", spec)
+	}
+	start, err := parseClock(startText)
+	if err != nil {
+		return Window{}, fmt.Errorf("invalid window %q: %w", spec, err)
+	}
+	end, err := parseClock(endText)
+	if err != nil {
+		return Window{}, fmt.Errorf("invalid window %q: %w", spec, err)
+	}
+	if start == end {
+		return Window{}, fmt.Errorf("invalid window %q: empty", spec)
+	}
+	return Window{Start: start, End: end, Location: loc}, nil
+}
+
+func parseClock(text string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(text))
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// Next vrátí nejbližší začátek okna po started
+func (w Window) Next(started time.Time) time.Time {
+	y, m, d := started.In(w.location()).Date()
+	for day := d; ; day++ {
+		if next := w.at(y, m, day, w.Start); next.After(started) {
+			return next
+		}
+	}
+}
+
+// Contains zjistí, zda t leží uvnitř okna
+func (w Window) Contains(t time.Time) bool {
+	return !w.EndAfter(t).IsZero()
+}
+
+// EndAfter vrátí konec okna, ve kterém leží t, nebo nulový čas, když t
+// v okně neleží
+func (w Window) EndAfter(t time.Time) time.Time {
+	y, m, d := t.In(w.location()).Date()
+	// Okno přes půlnoc mohlo začít už předchozí den
+	for _, day := range []int{d, d - 1} {
+		start := w.at(y, m, day, w.Start)
+		endDay := day
+		if w.End < w.Start {
+			endDay++
+		}
+		end := w.at(y, m, endDay, w.End)
+		if !t.Before(start) && t.Before(end) {
+			return end
+		}
+	}
+	return time.Time{}
+}
+
+// at vrátí čas clock (od půlnoci) daného dne podle hodin v okně. Počítá
+// se z kalendářního data, ne přičtením k půlnoci, aby ve dnech přechodu na
+// letní a zimní čas začínalo okno ve stejnou hodinu jako jindy. Den mimo
+// měsíc (32. 1.) time.Date převede na další měsíc.
+func (w Window) at(year int, month time.Month, day int, clock time.Duration) time.Time {
+	return time.Date(year, month, day, int(clock/time.Hour), int(clock%time.Hour/time.Minute), 0, 0, w.location())
+}
+
+func (w Window) location() *time.Location {
+	if w.Location == nil {
+		return time.Local
+	}
+	return w.Location
+}
+
+func (w Window) String() string {
+	format := func(d time.Duration) string {
+		return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+	}
+	return fmt.Sprintf("denně %s-%s (%s)", format(w.Start), format(w.End), w.location())
+}
+
+// Job je jedna naplánovaná úloha
+type Job struct {
+	Name     string
+	Schedule Schedule
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler spouští úlohy podle jejich rozvrhu. Úlohy běží vždy po jedné;
+// úloha, na kterou přijde řada během jiné, počká, až ta doběhne.
+type Scheduler struct {
+	jobs []*entry
+	out  io.Writer
+
+	stopOnce sync.Once
+	stop     chan struct{}
+}
+
+type entry struct {
+	job  Job
+	next time.Time
+}
+
+// New připraví scheduler; průběh vypisuje do out (nil = stdout)
+func New(out io.Writer, jobs ...Job) *Scheduler {
+	if out == nil {
+		out = os.Stdout
+	}
+	s := &Scheduler{out: out, stop: make(chan struct{})}
+	now := time.Now()
+	for _, job := range jobs {
+		next := now
+		// Okno se nespouští hned, jen když právě probíhá
+		if w, ok := job.Schedule.(Window); ok && !w.Contains(now) {
+			next = w.Next(now)
+		}
+		s.jobs = append(s.jobs, &entry{job: job, next: next})
+	}
+	return s
+}
+
+// Run spouští úlohy, dokud se nezavolá Stop nebo nezruší ctx. Rozpracovaná
+// úloha se po Stop dokončí (o jejím šetrném ukončení rozhoduje volající),
+// zrušení ctx se předá i do ní.
+func (s *Scheduler) Run(ctx context.Context) error {
+	if len(s.jobs) == 0 {
+		return fmt.Errorf("no jobs scheduled")
+	}
+	for _, e := range s.jobs {
+		fmt.Fprintf(s.out, "🗓️  %s: %s, poprvé %s\n", e.job.Name, e.job.Schedule, e.next.Format("02.01. 15:04"))
+	}
+
+	for {
+		e := s.nextEntry()
+		wait := time.Until(e.next)
+		if wait > 0 {
+			fmt.Fprintf(s.out, "💤 Další úloha %s v %s\n", e.job.Name, e.next.Format("02.01. 15:04"))
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-s.stop:
+			timer.Stop()
+			return nil
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		started := time.Now()
+		fmt.Fprintf(s.out, "▶️  Spouštím %s\n", e.job.Name)
+		err := e.job.Run(ctx)
+		took := time.Since(started).Round(time.Second)
+		if err != nil {
+			fmt.Fprintf(s.out, "❌ %s selhal po %v: %v\n", e.job.Name, took, err)
+		} else {
+			fmt.Fprintf(s.out, "✅ %s hotovo za %v\n", e.job.Name, took)
+		}
+		e.next = e.job.Schedule.Next(started)
+
+		select {
+		case <-s.stop:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+	}
+}
+
+// nextEntry vrátí úlohu, která je na řadě nejdřív; při shodě má přednost
+// ta zadaná dřív
+func (s *Scheduler) nextEntry() *entry {
+	next := s.jobs[0]
+	for _, e := range s.jobs[1:] {
+		if e.next.Before(next.next) {
+			next = e
+		}
+	}
+	return next
+}
+
+// Stop ukončí Run po dokončení rozpracované úlohy
+func (s *Scheduler) Stop() {
+	s.stopOnce.Do(func() { close(s.stop) })
+}
@@ -0,0 +1,173 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+func mustWindow(t *testing.T, spec string, loc *time.Location) Window {
+	t.Helper()
+	w, err := ParseWindow(spec, loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+func TestParseWindow(t *testing.T) {
+	w := mustWindow(t, " 02:00 - 05:30 ", time.UTC)
+	if w.Start != 2*time.Hour || w.End != 5*time.Hour+30*time.Minute {
+		t.Errorf("ParseWindow = %v-%v, want 2h-5h30m", w.Start, w.End)
+	}
+	if got, want := w.String(), "denně 02:00-05:30 (UTC)"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	for _, spec := range []string{"", "02:00", "02:00-", "2-5", "25:00-05:00", "03:00-03:00"} {
+		if _, err := ParseWindow(spec, time.UTC); err == nil {
+			t.Errorf("ParseWindow(%q): expected error", spec)
+		}
+	}
+}
+
+func TestWindow(t *testing.T) {
+	bratislava, err := time.LoadLocation("Europe/Bratislava")
+	if err != nil {
+		t.Skipf("no tzdata: %v", err)
+	}
+	at := func(value string) time.Time {
+		t.Helper()
+		parsed, err := time.ParseInLocation("2006-01-02 15:04", value, bratislava)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return parsed
+	}
+	// utc je okamžik zadaný v UTC; ve dnech přechodu času tak nezávisí na
+	// tom, jak time.ParseInLocation vyřeší neexistující nebo dvojí hodinu
+	utc := func(value string) time.Time {
+		t.Helper()
+		parsed, err := time.Parse("2006-01-02 15:04", value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return parsed
+	}
+
+	tests := []struct {
+		name   string
+		spec   string
+		now    time.Time
+		next   time.Time // Next(now)
+		end    time.Time // EndAfter(now), nulový = mimo okno
+		inside bool
+	}{
+		{
+			name: "před oknem",
+			spec: "02:00-05:00", now: at("2025-07-01 01:00"),
+			next: at("2025-07-01 02:00"),
+		},
+		{
+			name: "v okně",
+			spec: "02:00-05:00", now: at("2025-07-01 03:00"),
+			next: at("2025-07-02 02:00"), end: at("2025-07-01 05:00"), inside: true,
+		},
+		{
+			name: "začátek okna patří do okna, konec ne",
+			spec: "02:00-05:00", now: at("2025-07-01 05:00"),
+			next: at("2025-07-02 02:00"),
+		},
+		{
+			name: "po okně",
+			spec: "02:00-05:00", now: at("2025-07-01 12:00"),
+			next: at("2025-07-02 02:00"),
+		},
+		{
+			name: "okno přes půlnoc před půlnocí",
+			spec: "22:00-04:00", now: at("2025-07-01 23:30"),
+			next: at("2025-07-02 22:00"), end: at("2025-07-02 04:00"), inside: true,
+		},
+		{
+			name: "okno přes půlnoc po půlnoci",
+			spec: "22:00-04:00", now: at("2025-07-02 01:00"),
+			next: at("2025-07-02 22:00"), end: at("2025-07-02 04:00"), inside: true,
+		},
+		{
+			name: "okno přes půlnoc mimo okno",
+			spec: "22:00-04:00", now: at("2025-07-02 12:00"),
+			next: at("2025-07-02 22:00"),
+		},
+		{
+			name: "okno přes konec měsíce",
+			spec: "22:00-04:00", now: at("2025-07-31 23:00"),
+			next: at("2025-08-01 22:00"), end: at("2025-08-01 04:00"), inside: true,
+		},
+		{
+			// 30. 3. 2025 se v 02:00 CET posunou hodiny na 03:00 CEST
+			name: "přechod na letní čas",
+			spec: "04:00-06:00", now: utc("2025-03-29 12:00"), // 13:00 CET
+			next: utc("2025-03-30 02:00"), // 04:00 CEST
+		},
+		{
+			name: "v okně ve dni přechodu na letní čas",
+			spec: "01:00-05:00", now: utc("2025-03-30 01:30"), // 03:30 CEST
+			next:   utc("2025-03-30 23:00"), // 31. 3. 01:00 CEST
+			end:    utc("2025-03-30 03:00"), // 05:00 CEST
+			inside: true,
+		},
+		{
+			// 26. 10. 2025 se v 03:00 CEST vrátí hodiny na 02:00 CET
+			name: "přechod na zimní čas",
+			spec: "04:00-06:00", now: utc("2025-10-25 12:00"), // 14:00 CEST
+			next: utc("2025-10-26 03:00"), // 04:00 CET
+		},
+		{
+			name: "v okně ve dni přechodu na zimní čas",
+			spec: "01:00-05:00", now: utc("2025-10-26 02:30"), // 03:30 CET
+			next:   utc("2025-10-27 00:00"), // 27. 10. 01:00 CET
+			end:    utc("2025-10-26 04:00"), // 05:00 CET
+			inside: true,
+		},
+		{
+			name: "okno přes půlnoc do dne přechodu na zimní čas",
+			spec: "22:00-04:00", now: utc("2025-10-25 21:00"), // 23:00 CEST
+			next:   utc("2025-10-26 21:00"), // 22:00 CET
+			end:    utc("2025-10-26 03:00"), // 04:00 CET
+			inside: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := mustWindow(t, tt.spec, bratislava)
+			if got := w.Next(tt.now); !got.Equal(tt.next) {
+				t.Errorf("Next(%v) = %v, want %v", tt.now.In(bratislava), got, tt.next.In(bratislava))
+			}
+			if got := w.EndAfter(tt.now); !got.Equal(tt.end) {
+				t.Errorf("EndAfter(%v) = %v, want %v", tt.now.In(bratislava), got, tt.end.In(bratislava))
+			}
+			if got := w.Contains(tt.now); got != tt.inside {
+				t.Errorf("Contains(%v) = %v, want %v", tt.now.In(bratislava), got, tt.inside)
+			}
+		})
+	}
+}
+
+func TestEvery(t *testing.T) {
+	started := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+	if got, want := Every(15*time.Minute).Next(started), started.Add(15*time.Minute); !got.Equal(want) {
+		t.Errorf("Next = %v, want %v", got, want)
+	}
+}
+
+func TestNextEntry(t *testing.T) {
+	now := time.Now()
+	s := &Scheduler{jobs: []*entry{
+		{job: Job{Name: "a"}, next: now.Add(time.Minute)},
+		{job: Job{Name: "b"}, next: now},
+		{job: Job{Name: "c"}, next: now},
+	}}
+	// Při shodě času má přednost úloha zadaná dřív
+	if got := s.nextEntry().job.Name; got != "b" {
+		t.Errorf("nextEntry = %s, want b", got)
+	}
+}